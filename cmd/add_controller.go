@@ -6,18 +6,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
-var validControllerMethods = map[string]bool{
-	"GET":    true,
-	"POST":   true,
-	"PUT":    true,
-	"DELETE": true,
-}
-
 // Flags
 var name string
+var routePath string
 var methods []string
 var corsEnabled bool
 var controllerLanguage string
@@ -39,8 +35,6 @@ func promptForControllerLanguage(message string) string {
 }
 
 func addNewController(cmd *cobra.Command, args []string) {
-	var err error
-
 	// To start let's make sure we have all the config values we
 	// need to create the route.
 	configSpinner := utils.CreateNewTerminalSpinner(
@@ -49,6 +43,19 @@ func addNewController(cmd *cobra.Command, args []string) {
 		"Failed configuring controller.",
 	)
 
+	// Use the project defaults for the options that weren't given.
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	if err != nil {
+		configSpinner.Fail()
+		utils.HandleError("Error reading config", err)
+	}
+	if controllerLanguage == "" {
+		controllerLanguage = projectConfig.Defaults.Language
+	}
+	if !cmd.Flags().Changed("cors") {
+		corsEnabled = projectConfig.Defaults.CorsEnabled
+	}
+
 	// Check the backend language is valid otherwise we need to prompt the user to
 	// choose their language.
 	switch controllerLanguage {
@@ -72,17 +79,40 @@ func addNewController(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// The route defaults to a single segment named after the controller. Nested
+	// routes are created inside the folders of their parent routes.
+	if routePath == "" {
+		routePath = fmt.Sprintf("/%s", name)
+	}
+	err = auto_pulumi.ValidateRoutePath(routePath)
+	if err != nil {
+		configSpinner.Fail()
+		utils.HandleError("Invalid route", err)
+	}
+	for _, existingRoute := range projectConfig.Routes {
+		if existingRoute.Name == name || existingRoute.Route == routePath {
+			configSpinner.Fail()
+			errMsg := fmt.Sprintf("Route %s already serves %s", existingRoute.Name, existingRoute.Route)
+			utils.HandleError(errMsg, nil)
+		}
+
+		err = auto_pulumi.CheckRouteParameters(routePath, existingRoute.Route)
+		if err != nil {
+			configSpinner.Fail()
+			utils.HandleError("Invalid route", err)
+		}
+	}
+
 	// Check the provided methods are valid.
 	var controllerMethods []string
 	for _, inputMethod := range methods {
-		method := strings.ToUpper(inputMethod)
-		if !validControllerMethods[method] {
+		if !auto_pulumi.IsControllerMethod(inputMethod) {
 			configSpinner.Fail()
 			errMsg := fmt.Sprintf("Unknown method provided: %s", inputMethod)
 			utils.HandleError(errMsg, nil)
 		}
 
-		controllerMethods = append(controllerMethods, strings.ToLower(method))
+		controllerMethods = append(controllerMethods, strings.ToLower(inputMethod))
 	}
 
 	// Create the controller file.
@@ -94,11 +124,12 @@ func addNewController(cmd *cobra.Command, args []string) {
 	)
 
 	// Create the controller file(s).
-	controllerPath, err := application.CreateNewController(name, methods, controllerLanguage)
+	controllerPath, err := application.CreateNewController(name, routePath, controllerMethods, controllerLanguage)
 	utils.CheckForNilAndHandleError(err, "Error creating controller files")
 
 	// Add the controller to the config.
-	err = AddAPIRouteToConfig(application.ApplicationConfigPath, name, fmt.Sprintf("/%s", name), controllerPath, corsEnabled)
+	route := application.CreateAPIRoute(name, routePath, controllerPath, corsEnabled)
+	err = config.AddRoute(application.ApplicationConfigPath, route)
 	utils.CheckForNilAndHandleError(err, "Error writing new controller to config")
 
 	// Output the controller has been created.
@@ -110,7 +141,8 @@ func init() {
 	RootCmd.AddCommand(addControllerCmd)
 
 	addControllerCmd.Flags().StringVar(&name, "name", "", "The name for the controller in camelCase.")
-	addControllerCmd.Flags().StringSliceVar(&methods, "methods", []string{"GET"}, "The methods for your route. GET, POST, PUT, & DELETE.")
-	addControllerCmd.Flags().StringVar(&controllerLanguage, "language", "", "The language you are using to write your controller.")
-	addControllerCmd.Flags().BoolVar(&corsEnabled, "cors", false, "Enable CORS on your path.")
+	addControllerCmd.Flags().StringVar(&routePath, "route", "", "The route path, like /users/{id}/posts. Defaults to /<name>.")
+	addControllerCmd.Flags().StringSliceVar(&methods, "methods", []string{"GET"}, "The methods for your route. GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS, & ANY.")
+	addControllerCmd.Flags().StringVar(&controllerLanguage, "language", "", "The language you are using to write your controller. Defaults to defaults.language in the config.")
+	addControllerCmd.Flags().BoolVar(&corsEnabled, "cors", false, "Enable CORS on your path. Defaults to defaults.corsenabled in the config.")
 }
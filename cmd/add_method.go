@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// Flags
+var (
+	addMethodName     string
+	addMethodMethods  []string
+	addMethodLanguage string
+)
+
+var addMethodCmd = &cobra.Command{
+	Use:   "add-method",
+	Short: "Add methods to an existing controller.",
+	Long:  "Add methods to an existing controller. Each method can be written in a different language than the rest of the controller.",
+	Run:   addMethod,
+}
+
+// existingControllerLanguage returns the language of the existing methods of a controller.
+// It is empty if it can't be detected.
+func existingControllerLanguage(route auto_pulumi.APIRoute) string {
+	existingMethods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+	if err != nil || len(existingMethods) == 0 {
+		return ""
+	}
+
+	language, err := auto_pulumi.DetectLambdaLanguage(path.Join(route.PathToFiles, existingMethods[0]))
+	if err != nil {
+		return ""
+	}
+
+	return language
+}
+
+func addMethod(cmd *cobra.Command, args []string) {
+	configSpinner := utils.CreateNewTerminalSpinner(
+		"Configuring methods",
+		"Successfully configured methods.",
+		"Failed configuring methods.",
+	)
+
+	if addMethodName == "" {
+		configSpinner.Fail()
+		utils.HandleError("Please provide the name of the controller with --name", nil)
+	}
+	if len(addMethodMethods) == 0 {
+		configSpinner.Fail()
+		utils.HandleError("Please provide the methods to add with --methods", nil)
+	}
+
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	if err != nil {
+		configSpinner.Fail()
+		utils.HandleError("Error reading config", err)
+	}
+
+	// Find the route of the controller.
+	var route auto_pulumi.APIRoute
+	found := false
+	for _, projectRoute := range projectConfig.Routes {
+		if projectRoute.Name == addMethodName {
+			route = projectRoute
+			found = true
+			break
+		}
+	}
+	if !found {
+		configSpinner.Fail()
+		utils.HandleError(fmt.Sprintf("Route %s does not exist", addMethodName), nil)
+	}
+
+	// Check the provided methods are valid.
+	var controllerMethods []string
+	for _, inputMethod := range addMethodMethods {
+		if !auto_pulumi.IsControllerMethod(inputMethod) {
+			configSpinner.Fail()
+			errMsg := fmt.Sprintf("Unknown method provided: %s", inputMethod)
+			utils.HandleError(errMsg, nil)
+		}
+
+		controllerMethods = append(controllerMethods, strings.ToLower(inputMethod))
+	}
+
+	// New methods are written in the language of the controller unless another one
+	// is given.
+	language := addMethodLanguage
+	if language == "" {
+		language = existingControllerLanguage(route)
+	}
+	if language == "" {
+		language = projectConfig.Defaults.Language
+	}
+
+	switch language {
+	case application.TypeScriptControllerLanguage, application.GoControllerLanguage, application.DotNetControllerLanguage:
+		break
+	case "":
+		language = promptForControllerLanguage("Please pick the language to write your methods with")
+		break
+	default:
+		language = promptForControllerLanguage("Unknown controller langauge provided. Please selected a valid language")
+		break
+	}
+
+	configSpinner.Stop()
+	methodSpinner := utils.CreateNewTerminalSpinner(
+		"Creating method files.",
+		"Successfully created methods.",
+		"Failed to create methods.",
+	)
+
+	// The methods of a controller are read from its folders, so the config doesn't
+	// need to change.
+	err = application.AddControllerMethods(route, controllerMethods, language)
+	if err != nil {
+		methodSpinner.Fail()
+		utils.HandleError("Error creating method files", err)
+	}
+
+	methodSpinner.Stop()
+	fmt.Println("Method command finished.")
+}
+
+func init() {
+	RootCmd.AddCommand(addMethodCmd)
+
+	addMethodCmd.Flags().StringVar(&addMethodName, "name", "", "The name of the controller to add the methods to.")
+	addMethodCmd.Flags().StringSliceVar(&addMethodMethods, "methods", nil, "The methods to add. GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS, & ANY.")
+	addMethodCmd.Flags().StringVar(&addMethodLanguage, "language", "", "The language to write the methods with. Defaults to the language of the controller.")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
 	"github.com/zchase/stevie/pkg/application"
 	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
 )
 
 func CreateAPIDeployment(environment string) (auto.Stack, error) {
@@ -15,26 +16,105 @@ func CreateAPIDeployment(environment string) (auto.Stack, error) {
 	// Check that user is authenticated.
 	username, err := auto_pulumi.GetCurrentPulumiUser()
 	if err != nil {
-		return auto.Stack{}, nil
+		return auto.Stack{}, err
 	}
 
-	// Read in the base config file.
-	appConfig, err := ReadBaseConfig(application.ApplicationConfigPath)
+	// Read in the config for the environment.
+	appConfig, err := config.LoadProjectForEnvironment(application.ApplicationConfigPath, environment)
 	if err != nil {
 		return auto.Stack{}, err
 	}
 
-	// Create the deploy function.
+	// Create the deploy function. The names of the secrets are read from the stack
+	// once it has been selected.
+	var secretNames []string
 	deployFunc := func(ctx *pulumi.Context) error {
-		return application.BuildAPIRoutes(ctx, appConfig.DashCaseName, environment, appConfig.Routes)
+		err := auto_pulumi.RegisterDefaultTags(ctx, appConfig.AWS.DefaultTags)
+		if err != nil {
+			return err
+		}
+
+		functionEnvironment := auto_pulumi.FunctionEnvironment{
+			Project:     appConfig.DashCaseName,
+			Environment: environment,
+			Variables:   appConfig.Variables,
+			Secrets:     auto_pulumi.SecretEnvironment(ctx, secretNames),
+			Functions:   appConfig.Functions,
+		}
+		return application.BuildAPIRoutes(ctx, appConfig.DashCaseName, environment, appConfig.Routes, functionEnvironment)
 	}
 
 	// Create the stack.
 	stackName := auto.FullyQualifiedStackName(username, appConfig.DashCaseName, environment)
-	stack, err := auto.UpsertStackInlineSource(context, stackName, appConfig.DashCaseName, deployFunc)
+	stack, err := auto_pulumi.UpsertStack(context, stackName, appConfig.DashCaseName, deployFunc)
+	if err != nil {
+		return auto.Stack{}, err
+	}
+
+	// Give the functions the secrets of the environment.
+	secretNames, err = auto_pulumi.SecretNames(context, stack)
 	if err != nil {
 		return auto.Stack{}, err
 	}
 
 	return stack, nil
 }
+
+// SelectEnvironmentStack selects the stack of an environment without a program, for
+// working with the stack config.
+func SelectEnvironmentStack(environment string) (auto.Stack, error) {
+	// Make sure the environment exists.
+	_, err := config.LoadEnvironment(application.ApplicationConfigPath, environment)
+	if err != nil {
+		return auto.Stack{}, err
+	}
+
+	username, err := auto_pulumi.GetCurrentPulumiUser()
+	if err != nil {
+		return auto.Stack{}, err
+	}
+
+	appConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	if err != nil {
+		return auto.Stack{}, err
+	}
+
+	stackName := auto.FullyQualifiedStackName(username, appConfig.DashCaseName, environment)
+	return auto_pulumi.UpsertStack(context.Background(), stackName, appConfig.DashCaseName, nil)
+}
+
+// GetStageURL gets the URL of the API Gateway stage for a deployed environment. It
+// only reads the stack outputs so the stack is selected without a program.
+func GetStageURL(environment string) (string, error) {
+	appConfig, err := config.LoadProjectForEnvironment(application.ApplicationConfigPath, environment)
+	if err != nil {
+		return "", err
+	}
+
+	stack, err := SelectEnvironmentStack(environment)
+	if err != nil {
+		return "", err
+	}
+
+	return auto_pulumi.GetStageURL(context.Background(), stack, appConfig.Routes)
+}
+
+// NewPulumiAction creates a Pulumi action for an environment using its AWS settings.
+func NewPulumiAction(environment string) (auto_pulumi.PulumiAction, error) {
+	// Make sure the environment exists.
+	_, err := config.LoadEnvironment(application.ApplicationConfigPath, environment)
+	if err != nil {
+		return auto_pulumi.PulumiAction{}, err
+	}
+
+	appConfig, err := config.LoadProjectForEnvironment(application.ApplicationConfigPath, environment)
+	if err != nil {
+		return auto_pulumi.PulumiAction{}, err
+	}
+
+	return auto_pulumi.PulumiAction{
+		Environment:      environment,
+		AWS:              appConfig.AWS,
+		CreateDeployment: CreateAPIDeployment,
+	}, nil
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+var configSecret bool
+
+// showConfig prints the effective config for an environment.
+func showConfig(cmd *cobra.Command, args []string) {
+	merged, err := config.LoadMerged(application.ApplicationConfigPath, Environment)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	// Print the config with the same indentation as the config files.
+	encoder := yaml3.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	err = encoder.Encode(merged)
+	utils.CheckForNilAndHandleError(err, "Error printing config")
+}
+
+// printConfigNode prints a scalar config value as is and anything else as YAML.
+func printConfigNode(node *yaml3.Node) {
+	if node.Kind == yaml3.ScalarNode {
+		fmt.Println(node.Value)
+		return
+	}
+
+	encoder := yaml3.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	err := encoder.Encode(node)
+	utils.CheckForNilAndHandleError(err, "Error printing config")
+}
+
+// isStackConfigKey checks if a key is for the Pulumi stack config, like aws:profile,
+// rather than the config files.
+func isStackConfigKey(key string) bool {
+	return strings.Contains(key, ":")
+}
+
+// stackConfigKey checks a stack config key can be set directly and returns the key
+// to use instead if it is set from the config files.
+func stackConfigKey(key string) string {
+	if strings.HasPrefix(key, auto_pulumi.SecretsNamespace+":") {
+		utils.HandleError("Secrets are set with stevie secrets set", nil)
+	}
+
+	if configKey, ok := auto_pulumi.ManagedStackConfigKeys[key]; ok {
+		return configKey
+	}
+
+	return ""
+}
+
+// getConfigValue prints a value from the config files or the stack config.
+func getConfigValue(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the config key", nil)
+	}
+	key := args[0]
+
+	if isStackConfigKey(key) {
+		if configKey := stackConfigKey(key); configKey != "" {
+			key = configKey
+		} else {
+			if Environment == "" {
+				utils.HandleError("Please provide an environment via the environment flag", nil)
+			}
+
+			stack, err := SelectEnvironmentStack(Environment)
+			utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", Environment))
+
+			value, err := stack.GetConfig(context.Background(), key)
+			utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error reading stack config %s", key))
+
+			fmt.Println(value.Value)
+			return
+		}
+	}
+
+	node, err := config.GetValue(application.ApplicationConfigPath, Environment, key)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	printConfigNode(node)
+}
+
+// setConfigValue sets a value in the config files or the stack config.
+func setConfigValue(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		utils.HandleError("Please provide the config key and value", nil)
+	}
+	key, value := args[0], args[1]
+
+	if isStackConfigKey(key) {
+		configKey := stackConfigKey(key)
+		if configKey == "" {
+			if Environment == "" {
+				utils.HandleError("Please provide an environment via the environment flag", nil)
+			}
+
+			stack, err := SelectEnvironmentStack(Environment)
+			utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", Environment))
+
+			err = stack.SetConfig(context.Background(), key, auto.ConfigValue{Value: value, Secret: configSecret})
+			utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error setting stack config %s", key))
+
+			utils.Printf("Set stack config %s for environment %s.\n", key, Environment)
+			return
+		}
+
+		// Stevie sets these from the config files on every run so set them there.
+		if key == "aws:assumeRole" {
+			utils.HandleError(fmt.Sprintf("%s is set from %s, set its rolearn, sessionname, and externalid keys instead", key, configKey), nil)
+		}
+		utils.Printf("%s is set from %s in the config, setting that instead.\n", key, configKey)
+		key = configKey
+	}
+
+	node, err := config.ParseValue(value)
+	utils.CheckForNilAndHandleError(err, "Invalid value")
+
+	// Only refuse the change for problems it adds so an unrelated problem doesn't
+	// block it.
+	description := config.BaseFilePath(application.ApplicationConfigPath)
+	if Environment != "" {
+		description = fmt.Sprintf("config for environment %s", Environment)
+	}
+	existingProblems := make(map[string]bool)
+	if merged, err := config.LoadMerged(application.ApplicationConfigPath, Environment); err == nil {
+		for _, problem := range application.ValidateConfigNode(description, merged) {
+			existingProblems[problem.Message] = true
+		}
+	}
+
+	check := func(effective *yaml3.Node) error {
+		var newProblems []string
+		for _, problem := range application.ValidateConfigNode(description, effective) {
+			if !existingProblems[problem.Message] {
+				newProblems = append(newProblems, problem.Message)
+			}
+		}
+		if len(newProblems) > 0 {
+			return fmt.Errorf("the change would make the config invalid: %s", strings.Join(newProblems, "; "))
+		}
+
+		return nil
+	}
+
+	err = config.SetValue(application.ApplicationConfigPath, Environment, key, node, check)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error setting %s", key))
+
+	if Environment != "" {
+		utils.Printf("Set %s for environment %s.\n", key, Environment)
+	} else {
+		utils.Printf("Set %s.\n", key)
+	}
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Work with the project config.",
+	Long:  `Work with the project config.`,
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the effective config for an environment.",
+	Long:  `Print the base config with the config for the environment merged on top of it.`,
+	Run:   showConfig,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print a config value.",
+	Long: `Print a config value. Keys are dotted paths into the config, like
+routes.users.functions.memory, and are read from the effective config of the
+environment. Keys with a colon, like aws:profile, are read from the Pulumi
+stack config of the environment.`,
+	Run: getConfigValue,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set KEY VALUE",
+	Short: "Set a config value.",
+	Long: `Set a config value. Keys are dotted paths into the config, like
+routes.users.functions.memory. The value is written to the config file of the
+environment if one is given, otherwise to the base config. Keys with a colon,
+like aws:profile, are set on the Pulumi stack config of the environment.`,
+	Run: setConfigValue,
+}
+
+func init() {
+	RootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+
+	configSetCmd.Flags().BoolVar(&configSecret, "secret", false, "Encrypt a stack config value.")
+}
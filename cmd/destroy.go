@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/zchase/stevie/pkg/application"
-	"github.com/zchase/stevie/pkg/auto_pulumi"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
@@ -21,14 +19,17 @@ func destroyAPI(cmd *cobra.Command, args []string) {
 		utils.HandleError("Please provide an environment via the environment flag", nil)
 	}
 
+	destroyEnvironment(ctx, Environment)
+}
+
+// destroyEnvironment destroys the API resources of an environment.
+func destroyEnvironment(ctx context.Context, environment string) {
 	// Create the preview action.
-	updateAction := auto_pulumi.PulumiAction{
-		Environment:      Environment,
-		CreateDeployment: CreateAPIDeployment,
-	}
+	updateAction, err := NewPulumiAction(environment)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
 
 	// Set up the preview action
-	err := updateAction.SetUp(ctx, application.ApplicationConfigPath)
+	err = updateAction.SetUp(ctx)
 	utils.CheckForNilAndHandleError(err, "Error setting up API resource destroy operation")
 
 	// Run the preview
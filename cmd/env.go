@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+var (
+	envCopyFrom    string
+	envCopySecrets bool
+	envDestroy     bool
+)
+
+// environmentExists checks if an environment has a config file.
+func environmentExists(env string) bool {
+	exists, err := utils.DoesFileExist(config.EnvironmentFilePath(application.ApplicationConfigPath, env))
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	return exists
+}
+
+// addEnvironment creates the config file and stack for a new environment.
+func addEnvironment(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the name of the environment", nil)
+	}
+	ctx := context.Background()
+	env := args[0]
+
+	err := config.ValidateEnvironmentName(env)
+	utils.CheckForNilAndHandleError(err, "Invalid environment name")
+	if environmentExists(env) {
+		utils.HandleError(fmt.Sprintf("Environment %s already exists", env), nil)
+	}
+	if envCopySecrets && envCopyFrom == "" {
+		utils.HandleError("Please provide the environment to copy the secrets from with --copy-from", nil)
+	}
+	if envCopyFrom != "" && !environmentExists(envCopyFrom) {
+		utils.HandleError(fmt.Sprintf("Environment %s does not exist", envCopyFrom), nil)
+	}
+
+	username, err := auto_pulumi.GetCurrentPulumiUser()
+	utils.CheckForNilAndHandleError(err, "Error checking for authenticated user")
+
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	// Create the config file.
+	if envCopyFrom != "" {
+		err = config.CopyEnvironment(application.ApplicationConfigPath, envCopyFrom, env)
+	} else {
+		err = config.SaveEnvironment(application.ApplicationConfigPath, config.Environment{
+			Name:        projectConfig.DashCaseName,
+			Environment: env,
+		})
+	}
+	utils.CheckForNilAndHandleError(err, "Error creating config file")
+
+	// Create the stack, removing the config file again if that fails so the
+	// command can be retried.
+	stackName, err := auto_pulumi.CreatePulumiProject(ctx, username, projectConfig.DashCaseName, env, projectConfig.Description)
+	if err != nil {
+		config.RemoveEnvironment(application.ApplicationConfigPath, env)
+		utils.HandleError("Error creating Pulumi stack", err)
+	}
+
+	// Only copy the secrets when asked to since they may be meant for the source
+	// environment alone.
+	if envCopySecrets {
+		sourceStack, err := SelectEnvironmentStack(envCopyFrom)
+		utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", envCopyFrom))
+
+		secrets, err := auto_pulumi.ReadSecrets(ctx, sourceStack)
+		utils.CheckForNilAndHandleError(err, "Error reading secrets")
+
+		stack, err := SelectEnvironmentStack(env)
+		utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", env))
+
+		for name, value := range secrets {
+			err = auto_pulumi.SetSecret(ctx, stack, name, value)
+			utils.CheckForNilAndHandleError(err, "Error copying secret")
+		}
+		if len(secrets) > 0 {
+			utils.Printf("Copied %d secret(s) from %s.\n", len(secrets), envCopyFrom)
+		}
+	}
+
+	utils.Printf("Created environment %s with stack %s.\n", env, stackName)
+}
+
+// listEnvironments prints the environments and the state of their stacks.
+func listEnvironments(cmd *cobra.Command, args []string) {
+	environments, err := config.ListEnvironments(application.ApplicationConfigPath)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	stacks, err := auto_pulumi.ListStacks(context.Background(), projectConfig.DashCaseName)
+	utils.CheckForNilAndHandleError(err, "Error listing Pulumi stacks")
+
+	table := utils.CreateTerminalTable()
+	table.AddRow("  Environment\tStack\tResources\tLast Update\n")
+	for _, env := range environments {
+		status := utils.TextColor("missing", color.FgRed)
+		resources := "-"
+		lastUpdate := "-"
+
+		if stack, ok := stacks[env]; ok {
+			switch {
+			case stack.UpdateInProgress:
+				status = utils.TextColor("updating", color.FgYellow)
+			case stack.ResourceCount != nil && *stack.ResourceCount > 0:
+				status = utils.TextColor("deployed", color.FgGreen)
+			default:
+				status = "empty"
+			}
+			if stack.ResourceCount != nil {
+				resources = fmt.Sprintf("%d", *stack.ResourceCount)
+			}
+			if stack.LastUpdate != "" {
+				lastUpdate = stack.LastUpdate
+			}
+		}
+
+		table.AddRow("  %s\t%s\t%s\t%s\n", env, status, resources, lastUpdate)
+	}
+
+	table.Print()
+}
+
+// removeEnvironment deletes the stack and config file of an environment.
+func removeEnvironment(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the name of the environment", nil)
+	}
+	ctx := context.Background()
+	env := args[0]
+
+	if !environmentExists(env) {
+		utils.HandleError(fmt.Sprintf("Environment %s does not exist", env), nil)
+	}
+
+	stack, err := SelectEnvironmentStack(env)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", env))
+
+	// Don't leave resources behind that nothing tracks anymore.
+	resourceCount, err := auto_pulumi.StackResourceCount(ctx, stack)
+	utils.CheckForNilAndHandleError(err, "Error reading stack")
+	if resourceCount > 0 {
+		if !envDestroy {
+			utils.HandleError(fmt.Sprintf("Environment %s still has %d resources deployed. Run stevie destroy -e %s first or pass --destroy", env, resourceCount, env), nil)
+		}
+
+		fmt.Printf("Destroying the resources of %s:\n", env)
+		destroyEnvironment(ctx, env)
+	}
+
+	err = auto_pulumi.RemoveStack(ctx, stack)
+	utils.CheckForNilAndHandleError(err, "Error removing Pulumi stack")
+
+	err = config.RemoveEnvironment(application.ApplicationConfigPath, env)
+	utils.CheckForNilAndHandleError(err, "Error removing config file")
+
+	utils.Printf("Removed environment %s.\n", env)
+
+	// The env file may hold secrets that aren't saved anywhere else so leave it.
+	envFileName := application.LocalEnvFileName(env)
+	if _, err := os.Stat(envFileName); err == nil {
+		utils.Printf("%s was left in place, delete it if you don't need it.\n", envFileName)
+	}
+}
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage the environments of the project.",
+	Long:  `Manage the environments of the project.`,
+}
+
+var envAddCmd = &cobra.Command{
+	Use:   "add NAME",
+	Short: "Add an environment.",
+	Long: `Add an environment by creating its config file and Pulumi stack. With
+--copy-from the config file of another environment is copied, and with
+--copy-secrets its secrets are copied too.`,
+	Run: addEnvironment,
+}
+
+var envListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the environments and the state of their stacks.",
+	Long:  `List the environments and the state of their stacks.`,
+	Run:   listEnvironments,
+}
+
+var envRemoveCmd = &cobra.Command{
+	Use:   "rm NAME",
+	Short: "Remove an environment.",
+	Long: `Remove an environment by deleting its Pulumi stack and config file. This
+is refused while the environment has resources deployed unless --destroy is
+passed.`,
+	Run: removeEnvironment,
+}
+
+func init() {
+	RootCmd.AddCommand(envCmd)
+	envCmd.AddCommand(envAddCmd)
+	envCmd.AddCommand(envListCmd)
+	envCmd.AddCommand(envRemoveCmd)
+
+	envAddCmd.Flags().StringVar(&envCopyFrom, "copy-from", "", "The environment to copy the config from.")
+	envAddCmd.Flags().BoolVar(&envCopySecrets, "copy-secrets", false, "Copy the secrets of the --copy-from environment too.")
+	envRemoveCmd.Flags().BoolVar(&envDestroy, "destroy", false, "Destroy the deployed resources before removing the environment.")
+}
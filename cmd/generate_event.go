@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// Flags
+var eventRouteName string
+var eventMethod string
+var eventName string
+var eventPathParameters map[string]string
+var eventQueryParameters []string
+var eventHeaders []string
+var eventBody string
+var eventBase64Encode bool
+
+// splitKeyValue splits a key=value flag value.
+func splitKeyValue(flagName string, value string) (string, string) {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		utils.HandleError(fmt.Sprintf("Invalid %s %q, expected key=value", flagName, value), nil)
+	}
+
+	return parts[0], parts[1]
+}
+
+// findRouteMethod finds a route in the config and checks it has a method.
+func findRouteMethod(projectConfig config.Project, routeName string, method string) auto_pulumi.APIRoute {
+	for _, route := range projectConfig.Routes {
+		if route.Name != routeName {
+			continue
+		}
+
+		methods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+		utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error reading methods for route %s", routeName))
+
+		for _, routeMethod := range methods {
+			if routeMethod == strings.ToLower(method) {
+				return route
+			}
+		}
+
+		utils.HandleError(fmt.Sprintf("Route %s does not have a %s method", routeName, method), nil)
+	}
+
+	utils.HandleError(fmt.Sprintf("Route %s does not exist", routeName), nil)
+	return auto_pulumi.APIRoute{}
+}
+
+// generateEvent creates an API Gateway proxy event fixture for a route.
+func generateEvent(cmd *cobra.Command, args []string) {
+	if eventRouteName == "" || eventMethod == "" {
+		utils.HandleError("Please provide a route and method via the route and method flags", nil)
+	}
+
+	// Read the config and find the route.
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	utils.CheckForNilAndHandleError(err, "Error reading base config")
+	route := findRouteMethod(projectConfig, eventRouteName, eventMethod)
+
+	// Bodies are expected to be JSON.
+	if eventBody != "" && !json.Valid([]byte(eventBody)) {
+		utils.HandleError("The body flag must be valid JSON", nil)
+	}
+
+	query := url.Values{}
+	for _, value := range eventQueryParameters {
+		key, queryValue := splitKeyValue("query", value)
+		query.Add(key, queryValue)
+	}
+
+	headers := http.Header{}
+	if eventBody != "" {
+		headers.Set("Content-Type", "application/json")
+	}
+	for _, value := range eventHeaders {
+		key, headerValue := splitKeyValue("header", value)
+		headers.Add(key, headerValue)
+	}
+
+	request, err := application.NewRouteEvent(route, eventMethod, application.EventOptions{
+		PathParameters:        eventPathParameters,
+		QueryStringParameters: query,
+		Headers:               headers,
+		Body:                  eventBody,
+		Base64Encode:          eventBase64Encode,
+	})
+	utils.CheckForNilAndHandleError(err, "Error generating event")
+
+	name := eventName
+	if name == "" {
+		name = strings.ToLower(eventMethod)
+	}
+
+	eventPath, err := application.WriteRouteEvent(route, eventMethod, name, request)
+	utils.CheckForNilAndHandleError(err, "Error saving event")
+
+	utils.Printf("Created event at %s\n", eventPath)
+}
+
+var generateEventCmd = &cobra.Command{
+	Use:   "generate-event",
+	Short: "Generate an API Gateway proxy event for a route.",
+	Long:  `Generate an API Gateway proxy event for a route and save it in the events folder of the route method.`,
+	Run:   generateEvent,
+}
+
+func init() {
+	RootCmd.AddCommand(generateEventCmd)
+
+	generateEventCmd.Flags().StringVarP(&eventRouteName, "route", "r", "", "The name of the route the event is for.")
+	generateEventCmd.Flags().StringVarP(&eventMethod, "method", "m", "", "The method of the route the event is for.")
+	generateEventCmd.Flags().StringVarP(&eventName, "name", "n", "", "The name of the event file. Defaults to the method.")
+	generateEventCmd.Flags().StringToStringVar(&eventPathParameters, "path-param", nil, "Path parameters as key=value.")
+	generateEventCmd.Flags().StringArrayVarP(&eventQueryParameters, "query", "q", nil, "Query string parameters as key=value. Can be repeated.")
+	generateEventCmd.Flags().StringArrayVarP(&eventHeaders, "header", "H", nil, "Headers as key=value. Can be repeated.")
+	generateEventCmd.Flags().StringVarP(&eventBody, "body", "b", "", "The JSON body of the request.")
+	generateEventCmd.Flags().BoolVar(&eventBase64Encode, "base64", false, "Base64 encode the body.")
+}
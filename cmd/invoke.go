@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+var invokeRouteName string
+var invokeMethod string
+var invokeEventPath string
+var invokeServices bool
+
+// invokeController runs a single controller method locally with an event file.
+func invokeController(cmd *cobra.Command, args []string) {
+	if invokeRouteName == "" || invokeMethod == "" || invokeEventPath == "" {
+		utils.HandleError("Please provide a route, method, and event via the route, method, and event flags", nil)
+	}
+
+	// Read the config.
+	projectConfig, err := config.LoadProjectForEnvironment(application.ApplicationConfigPath, Environment)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	// Load the variables for the environment.
+	environment, err := application.LoadLocalEnvironment(application.ApplicationConfigPath, Environment)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error loading environment %s", Environment))
+
+	// Find the route.
+	for _, route := range projectConfig.Routes {
+		if route.Name != invokeRouteName {
+			continue
+		}
+
+		response, err := application.InvokeRouteLocally(route, invokeMethod, invokeEventPath, environment, invokeServices)
+		utils.CheckForNilAndHandleError(err, "Error invoking controller")
+
+		application.PrintAPIGatewayProxyResponse(response)
+		return
+	}
+
+	utils.HandleError(fmt.Sprintf("Route %s does not exist", invokeRouteName), nil)
+}
+
+var invokeCmd = &cobra.Command{
+	Use:   "invoke",
+	Short: "Run a single controller method locally with an event file.",
+	Long:  `Build a single controller method, run it locally with an API Gateway proxy event, and print the response.`,
+	Run:   invokeController,
+}
+
+func init() {
+	RootCmd.AddCommand(invokeCmd)
+
+	invokeCmd.Flags().StringVarP(&invokeRouteName, "route", "r", "", "The name of the route to invoke.")
+	invokeCmd.Flags().StringVarP(&invokeMethod, "method", "m", "", "The method of the route to invoke.")
+	invokeCmd.Flags().StringVar(&invokeEventPath, "event", "", "The path to a JSON file with the API Gateway proxy event.")
+	invokeCmd.Flags().StringVar(&Environment, "env", "", "The environment to invoke the controller in.")
+	invokeCmd.Flags().BoolVar(&invokeServices, "services", true, "Run the controller against a local DynamoDB and S3.")
+}
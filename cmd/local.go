@@ -1,23 +1,73 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/local_services"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
 var localServerPort int
+var localServerWatch bool
+var localRecordDirectory string
+var localOnlyRoutes []string
+var localFallbackEnvironment string
+var localServices bool
+var localServicesPort int
 
 // runLocal runs the API routes locally.
 func runLocal(cmd *cobra.Command, args []string) {
 	utils.Print("Running API Routes locally.")
 
-	// Read the config.
-	config, err := ReadBaseConfig(application.ApplicationConfigPath)
-	utils.CheckForNilAndHandleError(err, "Error reading base config")
+	// Make sure the config is valid before doing anything.
+	ValidateProjectConfig(Environment)
+
+	// Read the routes from the config. This is also used to pick up
+	// changes to the config while the server is running.
+	loadRoutes := func() ([]auto_pulumi.APIRoute, error) {
+		projectConfig, err := config.LoadProjectForEnvironment(application.ApplicationConfigPath, Environment)
+		if err != nil {
+			return nil, err
+		}
+
+		return projectConfig.Routes, nil
+	}
+
+	// Load the variables for the environment.
+	environment, err := application.LoadLocalEnvironment(application.ApplicationConfigPath, Environment)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error loading environment %s", Environment))
+
+	// Find where to forward the routes that aren't run locally.
+	var fallbackURL string
+	if localFallbackEnvironment != "" {
+		fallbackURL, err = GetStageURL(localFallbackEnvironment)
+		utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error getting stage URL for environment %s", localFallbackEnvironment))
+	}
+
+	// Reload the routes when the base config or the config of the environment changes.
+	configFilePaths := []string{config.BaseFilePath(application.ApplicationConfigPath)}
+	if Environment != "" {
+		configFilePaths = append(configFilePaths, config.EnvironmentFilePath(application.ApplicationConfigPath, Environment))
+	}
 
 	// Run the routes.
-	err = application.RunTypeScriptRoutesLocally(config.Routes, localServerPort)
+	err = application.RunRoutesLocally(application.LocalServerOptions{
+		Port:            localServerPort,
+		Watch:           localServerWatch,
+		ConfigFilePaths: configFilePaths,
+		LoadRoutes:      loadRoutes,
+		Environment:     environment,
+
+		RecordDirectory: localRecordDirectory,
+		Only:            localOnlyRoutes,
+		FallbackURL:     fallbackURL,
+		Services:        localServices,
+		ServicesPort:    localServicesPort,
+	})
 	utils.CheckForNilAndHandleError(err, "Error running local server")
 
 	utils.Print("Finished running local server")
@@ -33,4 +83,10 @@ func init() {
 	RootCmd.AddCommand(localCmd)
 
 	localCmd.Flags().IntVarP(&localServerPort, "port", "p", 3000, "The port you'd like to run your server on. Defaults to 3000.")
+	localCmd.Flags().StringSliceVar(&localOnlyRoutes, "only", nil, "The names of the routes to run locally. Defaults to every route.")
+	localCmd.Flags().StringVar(&localFallbackEnvironment, "fallback", "", "A deployed environment to forward requests for the other routes to.")
+	localCmd.Flags().StringVar(&localRecordDirectory, "record", "", "A directory to save each request and response to as fixtures.")
+	localCmd.Flags().BoolVar(&localServices, "services", true, "Run a local DynamoDB and S3 for the controllers to use.")
+	localCmd.Flags().IntVar(&localServicesPort, "services-port", local_services.DefaultPort, "The port to run the local DynamoDB and S3 on.")
+	localCmd.Flags().BoolVar(&localServerWatch, "watch", true, "Rebuild controllers and reload the config when they change.")
 }
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+var migrateConfigDryRun bool
+
+// checkConfigVersion stops any command from running against a config that is newer
+// than this version of Stevie understands.
+func checkConfigVersion(cmd *cobra.Command, args []string) {
+	configFilePath := config.BaseFilePath(application.ApplicationConfigPath)
+	exists, err := utils.DoesFileExist(configFilePath)
+	if err != nil || !exists {
+		return
+	}
+
+	err = config.CheckConfigVersion(configFilePath)
+	utils.CheckForNilAndHandleError(err, "Error checking config version")
+}
+
+// migrateConfig upgrades the base config to the current version.
+func migrateConfig(cmd *cobra.Command, args []string) {
+	configFilePath := config.BaseFilePath(application.ApplicationConfigPath)
+	result, err := config.MigrateConfigFile(configFilePath)
+	utils.CheckForNilAndHandleError(err, "Error migrating config")
+
+	if len(result.Applied) == 0 {
+		utils.Printf("%s is already at config version %d.\n", configFilePath, config.CurrentConfigVersion)
+		return
+	}
+
+	utils.Print("Migrations:")
+	for _, migration := range result.Applied {
+		utils.Printf("    - %d: %s\n", migration.Version, migration.Description)
+	}
+	utils.Print("")
+	utils.Print(utils.DiffLines(result.OldContents, result.NewContents))
+	utils.Print("")
+	if result.Reformatted {
+		utils.Print("The rest of the file will also be reformatted.")
+	}
+
+	if migrateConfigDryRun {
+		utils.Print("Dry run, the config was not changed.")
+		return
+	}
+
+	err = config.WriteFile(configFilePath, []byte(result.NewContents))
+	utils.CheckForNilAndHandleError(err, "Error writing config file")
+
+	utils.Print(utils.TextColor(fmt.Sprintf("Migrated config to version %d.", config.CurrentConfigVersion), color.FgGreen))
+}
+
+var migrateConfigCmd = &cobra.Command{
+	Use:   "migrate-config",
+	Short: "Upgrade the project config to the current version.",
+	Long:  `Upgrade the project config to the current version, showing the changes before writing them.`,
+	Run:   migrateConfig,
+}
+
+func init() {
+	RootCmd.AddCommand(migrateConfigCmd)
+
+	migrateConfigCmd.Flags().BoolVar(&migrateConfigDryRun, "dry-run", false, "Show the changes without writing them.")
+}
@@ -5,8 +5,6 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"github.com/zchase/stevie/pkg/application"
-	"github.com/zchase/stevie/pkg/auto_pulumi"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
@@ -24,14 +22,15 @@ func previewAPIChanges(cmd *cobra.Command, args []string) {
 		utils.HandleError("Please provide an environment via the environment flag", nil)
 	}
 
+	// Make sure the config is valid before doing anything.
+	ValidateProjectConfig(Environment)
+
 	// Create the preview action.
-	previewAction := auto_pulumi.PulumiAction{
-		Environment:      Environment,
-		CreateDeployment: CreateAPIDeployment,
-	}
+	previewAction, err := NewPulumiAction(Environment)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
 
 	// Set up the preview action
-	err := previewAction.SetUp(ctx, application.ApplicationConfigPath)
+	err = previewAction.SetUp(ctx)
 	utils.CheckForNilAndHandleError(err, "Error setting up preview")
 
 	// Run the preview
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// Flags
+var (
+	removeControllerName   string
+	removeControllerMethod string
+	removeControllerYes    bool
+)
+
+// printRemovedResources prints the resources the next update will delete.
+func printRemovedResources(resources []auto_pulumi.StackResource) {
+	fmt.Println("The next update of each environment will delete these resources:")
+	for _, resource := range resources {
+		fmt.Printf("  - %s %s\n", utils.TextColor(resource.Name, color.FgRed), resource.Type)
+	}
+}
+
+// confirmRemoval asks the user to confirm removing the controller files unless
+// --yes was given.
+func confirmRemoval(label string) {
+	if removeControllerYes {
+		return
+	}
+
+	confirmed, err := utils.PromptConfirm(label)
+	utils.CheckForNilAndHandleError(err, "Error prompting for confirmation")
+	if !confirmed {
+		utils.HandleError("Nothing was removed", nil)
+	}
+}
+
+func removeController(cmd *cobra.Command, args []string) {
+	if removeControllerName == "" {
+		utils.HandleError("Please provide the name of the controller with --name", nil)
+	}
+
+	projectConfig, err := config.LoadProject(application.ApplicationConfigPath)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
+
+	// Find the route and the routes that stay.
+	var route auto_pulumi.APIRoute
+	var otherRoutes []auto_pulumi.APIRoute
+	found := false
+	for _, projectRoute := range projectConfig.Routes {
+		if projectRoute.Name == removeControllerName {
+			route = projectRoute
+			found = true
+		} else {
+			otherRoutes = append(otherRoutes, projectRoute)
+		}
+	}
+	if !found {
+		utils.HandleError(fmt.Sprintf("Route %s does not exist", removeControllerName), nil)
+	}
+
+	// A controller whose folder is already gone can still be removed from the config.
+	methods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+	if err != nil && removeControllerMethod != "" {
+		utils.HandleError(fmt.Sprintf("Error reading methods for route %s", route.Name), err)
+	}
+
+	// Remove a single method.
+	if removeControllerMethod != "" {
+		method := strings.ToLower(removeControllerMethod)
+		if !auto_pulumi.IsControllerMethod(method) {
+			utils.HandleError(fmt.Sprintf("Unknown method provided: %s", removeControllerMethod), nil)
+		}
+
+		hasMethod := false
+		for _, routeMethod := range methods {
+			hasMethod = hasMethod || routeMethod == method
+		}
+		if !hasMethod {
+			utils.HandleError(fmt.Sprintf("Route %s does not have a %s method", route.Name, method), nil)
+		}
+		if len(methods) == 1 {
+			utils.HandleError(fmt.Sprintf("%s is the only method of route %s, remove the whole controller by leaving out --method", method, route.Name), nil)
+		}
+
+		fmt.Printf("Removing the %s method of route %s (%s).\n", strings.ToUpper(method), route.Name, route.Route)
+		printRemovedResources(auto_pulumi.MethodResources(route, method))
+		confirmRemoval(fmt.Sprintf("Remove the %s method", strings.ToUpper(method)))
+
+		err = application.RemoveControllerMethod(route, method)
+		utils.CheckForNilAndHandleError(err, "Error removing controller files")
+
+		err = config.RemoveRouteMethod(application.ApplicationConfigPath, route.Name, method)
+		utils.CheckForNilAndHandleError(err, "Error removing method from config")
+
+		fmt.Println("Method removed. Run update to delete its resources.")
+		return
+	}
+
+	// Remove the whole route.
+	fmt.Printf("Removing route %s (%s) and its controller in %s.\n", route.Name, route.Route, route.PathToFiles)
+	printRemovedResources(auto_pulumi.RouteResources(route, methods, otherRoutes))
+	confirmRemoval(fmt.Sprintf("Remove the %s controller", route.Name))
+
+	err = application.RemoveController(route, otherRoutes)
+	utils.CheckForNilAndHandleError(err, "Error removing controller files")
+
+	err = config.RemoveRoute(application.ApplicationConfigPath, route.Name)
+	utils.CheckForNilAndHandleError(err, "Error removing route from config")
+
+	fmt.Println("Controller removed. Run update to delete its resources.")
+}
+
+var removeControllerCmd = &cobra.Command{
+	Use:   "remove-controller",
+	Short: "Remove a controller or one of its methods.",
+	Long:  "Remove a controller or one of its methods from the project and its config. The resources are deleted by the next update.",
+	Run:   removeController,
+}
+
+func init() {
+	RootCmd.AddCommand(removeControllerCmd)
+
+	removeControllerCmd.Flags().StringVar(&removeControllerName, "name", "", "The name of the controller to remove.")
+	removeControllerCmd.Flags().StringVar(&removeControllerMethod, "method", "", "Only remove this method of the controller.")
+	removeControllerCmd.Flags().BoolVarP(&removeControllerYes, "yes", "y", false, "Remove the controller without asking for confirmation.")
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+var replayTargetURL string
+
+// getReplayTargetURL gets the URL to replay the fixtures against. This is the stage
+// URL of the environment if one is set and the local server otherwise.
+func getReplayTargetURL() string {
+	if replayTargetURL != "" {
+		return replayTargetURL
+	}
+
+	if Environment == "" {
+		return fmt.Sprintf("http://localhost:%d", localServerPort)
+	}
+
+	stageURL, err := GetStageURL(Environment)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error getting stage URL for environment %s", Environment))
+
+	return stageURL
+}
+
+// replayFixtures resends recorded requests and diffs the responses.
+func replayFixtures(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the directory of the recorded fixtures", nil)
+	}
+
+	fixtureFiles, err := application.ReadLocalFixtures(args[0])
+	utils.CheckForNilAndHandleError(err, "Error reading fixtures")
+	if len(fixtureFiles) == 0 {
+		utils.HandleError(fmt.Sprintf("No fixtures found in %s", args[0]), nil)
+	}
+
+	targetURL := getReplayTargetURL()
+	utils.Printf("Replaying %d requests against %s\n\n", len(fixtureFiles), targetURL)
+
+	failures := 0
+	for _, fixtureFile := range fixtureFiles {
+		fixture := fixtureFile.Fixture
+		description := fmt.Sprintf("%s %s (%s)", fixture.Request.Method, fixture.Request.Path, fixtureFile.Path)
+
+		response, err := application.ReplayLocalFixture(targetURL, fixture)
+		if err != nil {
+			failures++
+			utils.Printf("%s %s: %v\n", utils.TextColor("FAIL", color.FgRed), description, err)
+			continue
+		}
+
+		diff := application.DiffLocalFixtureResponse(fixture.Response, response)
+		if diff != "" {
+			failures++
+			utils.Printf("%s %s\n%s\n", utils.TextColor("FAIL", color.FgRed), description, diff)
+			continue
+		}
+
+		utils.Printf("%s %s\n", utils.TextColor("PASS", color.FgGreen), description)
+	}
+
+	utils.Print("")
+	if failures > 0 {
+		utils.HandleError(fmt.Sprintf("%d of %d responses did not match", failures, len(fixtureFiles)), nil)
+	}
+	utils.Printf("All %d responses matched.\n", len(fixtureFiles))
+}
+
+var replayCmd = &cobra.Command{
+	Use:   "replay [directory]",
+	Short: "Replay recorded requests and diff the responses.",
+	Long:  `Replay the requests recorded with stevie local --record against the local server or a deployed environment and diff the responses.`,
+	Run:   replayFixtures,
+}
+
+func init() {
+	RootCmd.AddCommand(replayCmd)
+
+	replayCmd.Flags().StringVar(&replayTargetURL, "target", "", "The URL to replay the requests against. Defaults to the environment's stage URL or the local server.")
+}
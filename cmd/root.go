@@ -12,6 +12,7 @@ var RootCmd = &cobra.Command{
 	Short: "Stevie helps you build serverless applications",
 	Long: `Stevie helps build serverless applications by letting you
 focus on your business logic instead of the cloud services needed to run it.`,
+	PersistentPreRun: checkConfigVersion,
 }
 
 func Execute() {
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// selectSecretsStack checks the environment flag and selects the stack the secrets
+// are stored in.
+func selectSecretsStack() auto.Stack {
+	if Environment == "" {
+		utils.HandleError("Please provide an environment via the environment flag", nil)
+	}
+
+	stack, err := SelectEnvironmentStack(Environment)
+	utils.CheckForNilAndHandleError(err, fmt.Sprintf("Error selecting the stack for environment %s", Environment))
+
+	return stack
+}
+
+// setSecret stores a secret for an environment.
+func setSecret(cmd *cobra.Command, args []string) {
+	if len(args) < 1 || len(args) > 2 {
+		utils.HandleError("Please provide the name of the secret and optionally its value", nil)
+	}
+
+	name := args[0]
+	err := auto_pulumi.ValidateVariableName(name)
+	utils.CheckForNilAndHandleError(err, "Invalid secret name")
+
+	// Prompt for the value so it doesn't end up in the shell history.
+	var value string
+	if len(args) == 2 {
+		value = args[1]
+	} else {
+		value, err = utils.PromptSecretString(fmt.Sprintf("Value for %s", name))
+		utils.CheckForNilAndHandleError(err, "Error reading secret value")
+	}
+
+	stack := selectSecretsStack()
+	err = auto_pulumi.SetSecret(context.Background(), stack, name, value)
+	utils.CheckForNilAndHandleError(err, "Error saving secret")
+
+	utils.Printf("Set secret %s for environment %s. Run stevie update to give it to your functions.\n", name, Environment)
+}
+
+// getSecret prints the value of a secret.
+func getSecret(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the name of the secret", nil)
+	}
+
+	stack := selectSecretsStack()
+	value, err := auto_pulumi.GetSecret(context.Background(), stack, args[0])
+	utils.CheckForNilAndHandleError(err, "Error reading secret")
+
+	fmt.Println(value)
+}
+
+// listSecrets prints the names of the secrets of an environment.
+func listSecrets(cmd *cobra.Command, args []string) {
+	stack := selectSecretsStack()
+	names, err := auto_pulumi.SecretNames(context.Background(), stack)
+	utils.CheckForNilAndHandleError(err, "Error reading secrets")
+
+	if len(names) == 0 {
+		utils.Printf("No secrets set for environment %s.\n", Environment)
+		return
+	}
+
+	for _, name := range names {
+		fmt.Println(name)
+	}
+}
+
+// removeSecret removes a secret from an environment.
+func removeSecret(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		utils.HandleError("Please provide the name of the secret", nil)
+	}
+
+	stack := selectSecretsStack()
+	err := auto_pulumi.RemoveSecret(context.Background(), stack, args[0])
+	utils.CheckForNilAndHandleError(err, "Error removing secret")
+
+	utils.Printf("Removed secret %s from environment %s. Run stevie update to remove it from your functions.\n", args[0], Environment)
+}
+
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Manage the secrets of an environment.",
+	Long: `Manage the secrets of an environment. Secrets are stored encrypted in the
+Pulumi stack config of the environment and every function gets them as
+environment variables.`,
+}
+
+var secretsSetCmd = &cobra.Command{
+	Use:   "set KEY [VALUE]",
+	Short: "Set a secret. You are prompted for the value if it isn't given.",
+	Long:  `Set a secret. You are prompted for the value if it isn't given.`,
+	Run:   setSecret,
+}
+
+var secretsGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print the value of a secret.",
+	Long:  `Print the value of a secret.`,
+	Run:   getSecret,
+}
+
+var secretsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the names of the secrets.",
+	Long:  `List the names of the secrets.`,
+	Run:   listSecrets,
+}
+
+var secretsRemoveCmd = &cobra.Command{
+	Use:   "rm KEY",
+	Short: "Remove a secret.",
+	Long:  `Remove a secret.`,
+	Run:   removeSecret,
+}
+
+func init() {
+	RootCmd.AddCommand(secretsCmd)
+	secretsCmd.AddCommand(secretsSetCmd)
+	secretsCmd.AddCommand(secretsGetCmd)
+	secretsCmd.AddCommand(secretsListCmd)
+	secretsCmd.AddCommand(secretsRemoveCmd)
+}
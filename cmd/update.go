@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/zchase/stevie/pkg/application"
-	"github.com/zchase/stevie/pkg/auto_pulumi"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
@@ -21,14 +19,15 @@ func updateAPIChanges(cmd *cobra.Command, args []string) {
 		utils.HandleError("Please provide an environment via the environment flag", nil)
 	}
 
+	// Make sure the config is valid before doing anything.
+	ValidateProjectConfig(Environment)
+
 	// Create the preview action.
-	updateAction := auto_pulumi.PulumiAction{
-		Environment:      Environment,
-		CreateDeployment: CreateAPIDeployment,
-	}
+	updateAction, err := NewPulumiAction(Environment)
+	utils.CheckForNilAndHandleError(err, "Error reading config")
 
 	// Set up the preview action
-	err := updateAction.SetUp(ctx, application.ApplicationConfigPath)
+	err = updateAction.SetUp(ctx)
 	utils.CheckForNilAndHandleError(err, "Error setting up update")
 
 	// Run the preview
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zchase/stevie/pkg/application"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// ValidateProjectConfig validates the effective config of an environment and exits
+// listing every problem if there are any. If env is empty only the base config is
+// validated.
+func ValidateProjectConfig(env string) {
+	problems, err := application.ValidateEnvironmentConfig(application.ApplicationConfigPath, env)
+	utils.CheckForNilAndHandleError(err, "Error validating config")
+
+	if len(problems) == 0 {
+		return
+	}
+
+	for _, problem := range problems {
+		utils.Print(utils.TextColor(problem.String(), color.FgRed))
+	}
+	utils.HandleError(fmt.Sprintf("Found %d problem(s) in the config", len(problems)), nil)
+}
+
+func validateConfig(cmd *cobra.Command, args []string) {
+	ValidateProjectConfig(Environment)
+	utils.Print(utils.TextColor("Config is valid.", color.FgGreen))
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the project config for problems.",
+	Long:  `Check the project config for problems like duplicate route names, missing controller folders, and colliding routes. With --environment the config of that environment is checked.`,
+	Run:   validateConfig,
+}
+
+func init() {
+	RootCmd.AddCommand(validateCmd)
+}
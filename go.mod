@@ -3,6 +3,7 @@ module github.com/zchase/stevie
 go 1.13
 
 require (
+	github.com/aws/aws-lambda-go v1.19.1
 	github.com/buger/goterm v0.0.0-20200322175922-2f3e71b85129
 	github.com/fatih/camelcase v1.0.0
 	github.com/fatih/color v1.10.0
@@ -12,5 +13,6 @@ require (
 	github.com/pulumi/pulumi-aws/sdk/v3 v3.16.0
 	github.com/pulumi/pulumi/sdk/v2 v2.14.0
 	github.com/spf13/cobra v1.1.1
-	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )
@@ -103,6 +103,14 @@ export function wrapRouteHandlerArgs(args: string[], body: any): ServerArgObject
     return result;
 }
 
+// createRequestArgs merges the values of a request that handler args are read from. Path
+// parameters win over query string parameters, which win over the body. The request
+// headers are available as the `headers` arg and are set last so a body or query string
+// with a `headers` field can't replace them.
+export function createRequestArgs(body: any, query: any, pathParameters: any, headers: any): any {
+    return Object.assign({}, body, query, pathParameters, { headers: headers || {} });
+}
+
 export const responseHandler: ResponseHandler = {
     send: (data: any) => createResponseObject(200, data),
     sendWithStatusCode: (code: number, data: any) => createResponseObject(code, data),
@@ -120,8 +128,8 @@ export function createRouteHandler(handler: RawRouteHandler): RouteHandler {
             body = JSON.parse(event.body);
         }
 
-        // Add the query params to the body.
-        const bodyWithQuery = Object.assign({}, body, event.queryStringParameters);
+        // Merge the request values the handler args are read from.
+        const requestArgs = createRequestArgs(body, event.queryStringParameters, event.pathParameters, event.headers);
 
         // Parse the args of the function. The first arg in each handler
         // should be the for the `response` object for handling the response.
@@ -129,7 +137,7 @@ export function createRouteHandler(handler: RawRouteHandler): RouteHandler {
         const wrappableHandlerArgs = handlerArgs.slice(1, handlerArgs.length);
 
         // Wrap the args for validation within the handler.
-        const params = wrapRouteHandlerArgs(wrappableHandlerArgs, bodyWithQuery);
+        const params = wrapRouteHandlerArgs(wrappableHandlerArgs, requestArgs);
 
         // Try the handler and return a 500 error for any errors.
         try {
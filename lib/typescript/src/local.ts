@@ -4,13 +4,13 @@ import * as bodyParser from "body-parser";
 import morgan from "morgan";
 
 import {
-    RawRouteHandler, responseHandler, parseFunctionArguments, wrapRouteHandlerArgs,
+    RawRouteHandler, responseHandler, parseFunctionArguments, wrapRouteHandlerArgs, createRequestArgs,
 } from "./controller_builder";
 
 function createRouteHandler(handler: RawRouteHandler) {
     return async (req: Request, res: Response) => {
-        // Grab the request body.
-        const body = Object.assign({}, req.body, req.query);
+        // Merge the request values the handler args are read from.
+        const body = createRequestArgs(req.body, req.query, req.params, req.headers);
 
         // Parse the args of the function. The first arg in each handler
         // should be the for the `response` object for handling the response.
@@ -37,10 +37,22 @@ function createRouteHandler(handler: RawRouteHandler) {
 
 export interface LocalServerRoute {
     path: string;
-    method: "get" | "put" | "post" | "delete";
+    method: "get" | "post" | "put" | "patch" | "delete" | "head" | "options" | "any";
     handler: RawRouteHandler;
 }
 
+/**
+ * toExpressPath converts a route path like /users/{id} to the Express path
+ * /users/:id. Greedy parameters like {proxy+} match the rest of the path.
+ *
+ * @param path The route path.
+ */
+export function toExpressPath(path: string): string {
+    return path
+        .replace(/\{([A-Za-z_][A-Za-z0-9_]*)\+\}/g, ":$1(*)")
+        .replace(/\{([A-Za-z_][A-Za-z0-9_]*)\}/g, ":$1");
+}
+
 export function createLocalServer(routes: LocalServerRoute[]): express.Application {
     // Create the application.
     const app = express();
@@ -50,9 +62,19 @@ export function createLocalServer(routes: LocalServerRoute[]): express.Applicati
     app.use(bodyParser.urlencoded({ extended: true }));
     app.use(morgan("dev"));
 
-    for (let i = 0; i < routes.length; i++) {
-        const route = routes[i];
-        app[route.method](route.path, createRouteHandler(route.handler));
+    // The catch-all handlers are added last so they only get the methods the other
+    // handlers for their route don't.
+    const methodRoutes = routes.filter((route) => route.method !== "any");
+    const catchAllRoutes = routes.filter((route) => route.method === "any");
+    for (let i = 0; i < methodRoutes.length; i++) {
+        const route = methodRoutes[i];
+        const method = route.method as Exclude<LocalServerRoute["method"], "any">;
+        app[method](toExpressPath(route.path), createRouteHandler(route.handler));
+    }
+
+    for (let i = 0; i < catchAllRoutes.length; i++) {
+        const route = catchAllRoutes[i];
+        app.all(toExpressPath(route.path), createRouteHandler(route.handler));
     }
 
     return app;
@@ -2,9 +2,11 @@ package application
 
 import (
 	"fmt"
+	"os"
 	"path"
 	"strings"
 
+	"github.com/zchase/stevie/pkg/auto_pulumi"
 	"github.com/zchase/stevie/pkg/utils"
 )
 
@@ -14,33 +16,77 @@ const (
 	DotNetControllerLanguage     = "dotnet"
 )
 
-// CreateNewController creates a new controller.
-func CreateNewController(name string, methods []string, language string) (string, error) {
-	// Create the main controller directory.
-	controllerDirectoryPath := path.Join(ApplicationFolder, ControllersFolder, name)
-	err := utils.CreateNewDirectory(controllerDirectoryPath)
+// ControllerDirectoryPath returns the directory of the controller for a route. The
+// controller folders follow the route path, so the controller for /users/{id}
+// lives in app/controllers/users/{id}.
+func ControllerDirectoryPath(route string) string {
+	return path.Join(append([]string{ApplicationFolder, ControllersFolder}, auto_pulumi.RouteSegments(route)...)...)
+}
+
+// CreateNewController creates a new controller for a route.
+func CreateNewController(name string, route string, methods []string, language string) (string, error) {
+	// Create the main controller directory inside the folders of its parent routes.
+	controllerDirectoryPath := ControllerDirectoryPath(route)
+	err := utils.CreateDirectoryPath(path.Dir(controllerDirectoryPath))
+	if err != nil {
+		return "", fmt.Errorf("Error creating parent controller directories: %v", err)
+	}
+
+	err = utils.CreateNewDirectory(controllerDirectoryPath)
 	if err != nil {
 		return "", fmt.Errorf("Error creating main controller directory: %v", err)
 	}
 
+	err = createControllerMethods(controllerDirectoryPath, name, route, methods, language)
+	if err != nil {
+		return "", err
+	}
+
+	return controllerDirectoryPath, nil
+}
+
+// AddControllerMethods adds methods to the existing controller of a route. The
+// methods can be written in a different language than the rest of the controller.
+func AddControllerMethods(route auto_pulumi.APIRoute, methods []string, language string) error {
+	existingMethods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+	if err != nil {
+		return fmt.Errorf("Error reading methods for route %s: %v", route.Name, err)
+	}
+
+	for _, method := range methods {
+		for _, existingMethod := range existingMethods {
+			if strings.EqualFold(method, existingMethod) {
+				return fmt.Errorf("Route %s already has a %s method", route.Name, existingMethod)
+			}
+		}
+	}
+
+	return createControllerMethods(route.PathToFiles, route.Name, route.Route, methods, language)
+}
+
+// createControllerMethods creates the handlers for methods in a controller directory.
+// The top level files the language needs are created if the controller doesn't
+// have them yet.
+func createControllerMethods(controllerDirectoryPath, name, route string, methods []string, language string) error {
 	// Create any top level files needed for the controller.
+	var err error
 	switch language {
 	case GoControllerLanguage:
 		err = createGoTopLevelFiles(controllerDirectoryPath)
 		if err != nil {
-			return "", err
+			return err
 		}
 		break
 	case TypeScriptControllerLanguage:
 		err = createTypeScriptTopLevelFiles(controllerDirectoryPath, name, "")
 		if err != nil {
-			return "", err
+			return err
 		}
 		break
 	case DotNetControllerLanguage:
 		break
 	default:
-		return "", fmt.Errorf("Language not supported: %s", language)
+		return fmt.Errorf("Language not supported: %s", language)
 	}
 
 	// Loop through the methods.
@@ -49,43 +95,139 @@ func CreateNewController(name string, methods []string, language string) (string
 		controllerHandlerDirectoryPath := path.Join(controllerDirectoryPath, method)
 		err = utils.CreateNewDirectory(controllerHandlerDirectoryPath)
 		if err != nil {
-			return "", fmt.Errorf("Error creating handler directory for %s method on route %s: %v", name, method, err)
+			return fmt.Errorf("Error creating handler directory for %s method on route %s: %v", name, method, err)
 		}
 
 		// Create the handler's files.
 		switch language {
 		case GoControllerLanguage:
-			err = createNewGoController(controllerHandlerDirectoryPath, name, method)
+			err = createNewGoController(controllerHandlerDirectoryPath, name, route, method)
 			if err != nil {
-				return "", err
+				return err
 			}
 			break
 		case TypeScriptControllerLanguage:
-			err = createNewTypeScriptController(controllerHandlerDirectoryPath, name, method)
+			err = createNewTypeScriptController(controllerHandlerDirectoryPath, name, route, method)
 			if err != nil {
-				return "", err
+				return err
 			}
 			break
 		case DotNetControllerLanguage:
-			err = createNewDotNetController(controllerHandlerDirectoryPath, name, method)
+			err = createNewDotNetController(controllerHandlerDirectoryPath, name, route, method)
 			if err != nil {
-				return "", err
+				return err
 			}
 			break
 		}
 	}
 
-	return controllerDirectoryPath, nil
+	return nil
+}
+
+// RouteParameterArgs describe a path parameter of a route to the controller templates.
+type RouteParameterArgs struct {
+	// Name is the name of the parameter in the route.
+	Name string
+
+	// FieldName is the name with its first letter in upper case, for the fields of
+	// the generated parameter types.
+	FieldName string
+}
+
+// routeParameterArgs returns the path parameters of a route for the controller templates.
+func routeParameterArgs(route string) []RouteParameterArgs {
+	var parameters []RouteParameterArgs
+	for _, parameter := range auto_pulumi.RouteParameters(route) {
+		parameters = append(parameters, RouteParameterArgs{
+			Name:      parameter,
+			FieldName: strings.ToUpper(parameter[:1]) + parameter[1:],
+		})
+	}
+
+	return parameters
+}
+
+// RemoveController deletes the files of the controller for a route. The folders of
+// the routes nested inside it are kept.
+func RemoveController(route auto_pulumi.APIRoute, otherRoutes []auto_pulumi.APIRoute) error {
+	controllerDirectoryPath := path.Clean(route.PathToFiles)
+
+	// Find the controllers that live inside this one.
+	var nestedPaths []string
+	for _, otherRoute := range otherRoutes {
+		otherPath := path.Clean(otherRoute.PathToFiles)
+		if strings.HasPrefix(otherPath, controllerDirectoryPath+"/") {
+			nestedPaths = append(nestedPaths, otherPath)
+		}
+	}
+
+	if len(nestedPaths) == 0 {
+		err := os.RemoveAll(controllerDirectoryPath)
+		if err != nil {
+			return fmt.Errorf("Error removing controller directory: %v", err)
+		}
+		return nil
+	}
+
+	contents, err := utils.ReadDirectoryContents(controllerDirectoryPath)
+	if err != nil {
+		return fmt.Errorf("Error reading controller directory: %v", err)
+	}
+
+	for _, content := range contents {
+		contentPath := path.Join(controllerDirectoryPath, content)
+
+		nested := false
+		for _, nestedPath := range nestedPaths {
+			if nestedPath == contentPath || strings.HasPrefix(nestedPath, contentPath+"/") {
+				nested = true
+				break
+			}
+		}
+		if nested {
+			continue
+		}
+
+		err = os.RemoveAll(contentPath)
+		if err != nil {
+			return fmt.Errorf("Error removing %s: %v", contentPath, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveControllerMethod deletes the folder of a method of a controller. Method
+// folders are matched without case like they are when the methods are read.
+func RemoveControllerMethod(route auto_pulumi.APIRoute, method string) error {
+	contents, err := utils.ReadDirectoryContents(route.PathToFiles)
+	if err != nil {
+		return fmt.Errorf("Error reading controller directory: %v", err)
+	}
+
+	for _, content := range contents {
+		if !strings.EqualFold(content, method) {
+			continue
+		}
+
+		err = os.RemoveAll(path.Join(route.PathToFiles, content))
+		if err != nil {
+			return fmt.Errorf("Error removing handler directory for %s method on route %s: %v", method, route.Name, err)
+		}
+	}
+
+	return nil
 }
 
 type DotNetControllerFileArgs struct {
 	Route        string
 	Method       string
 	FunctionName string
+	Parameters   []RouteParameterArgs
 }
 
 // createNewDotNetController creates a new dotnet controller.
-func createNewDotNetController(dirPath, name, method string) error {
+func createNewDotNetController(dirPath, name, route, method string) error {
 	dotNetControllerTemplatePath := path.Join(FileTemplatePath, DotNetFileTemplateDirectoryName, "controller.tmpl")
 	dotNetControllerFileName := fmt.Sprintf("%s.cs", utils.DashCaseToSentenceCase(method))
 	dotNetControllerFilePath := path.Join(dirPath, dotNetControllerFileName)
@@ -93,7 +235,8 @@ func createNewDotNetController(dirPath, name, method string) error {
 	err := utils.WriteOutTemplateToFile(dotNetControllerTemplatePath, dotNetControllerFilePath, DotNetControllerFileArgs{
 		FunctionName: utils.DashCaseToSentenceCase(method),
 		Method:       method,
-		Route:        fmt.Sprintf("/%s", name),
+		Route:        route,
+		Parameters:   routeParameterArgs(route),
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating controller file: %v", err)
@@ -109,52 +252,75 @@ func createNewDotNetController(dirPath, name, method string) error {
 	return nil
 }
 
-// createGoTopLevelFiles creates the top level files for a go controller.
+// createGoTopLevelFiles creates the top level files for a go controller. Files the
+// project already has are left as they are.
 func createGoTopLevelFiles(dirPath string) error {
 	// Create the go.mod and go.sum files.
-	err := utils.WriteNewFile("", GoGoModName, "")
-	if err != nil {
-		return fmt.Errorf("Error creating go.mod: %v", err)
-	}
+	for _, fileName := range []string{GoGoModName, GoGoSumName} {
+		exists, err := utils.DoesFileExist(fileName)
+		if err != nil {
+			return fmt.Errorf("Error checking for %s: %v", fileName, err)
+		}
+		if exists {
+			continue
+		}
 
-	err = utils.WriteNewFile("", GoGoSumName, "")
-	if err != nil {
-		return fmt.Errorf("Error creating go.sum: %v", err)
+		err = utils.WriteNewFile("", fileName, "")
+		if err != nil {
+			return fmt.Errorf("Error creating %s: %v", fileName, err)
+		}
 	}
 
 	return nil
 }
 
 type GoControllerFileArgs struct {
-	Method string
-	Route  string
+	Method     string
+	Route      string
+	Parameters []RouteParameterArgs
 }
 
 // CreateNewGoController creates a new Go controller.
-func createNewGoController(dirPath, name, method string) error {
+func createNewGoController(dirPath, name, route, method string) error {
 	goControllerTemplatePath := path.Join(FileTemplatePath, GoFileTemplatesDirectoryName, "controller.tmpl")
 	goControllerFileName := fmt.Sprintf("%s.go", strings.ToLower(method))
 	goControllerFilePath := path.Join(dirPath, goControllerFileName)
 
 	err := utils.WriteOutTemplateToFile(goControllerTemplatePath, goControllerFilePath, GoControllerFileArgs{
-		Method: method,
-		Route:  fmt.Sprintf("/%s", name),
+		Method:     method,
+		Route:      route,
+		Parameters: routeParameterArgs(route),
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating controller file: %v", err)
 	}
 
+	// Format the file so the generated parameter declarations line up.
+	err = utils.FormatGoFile(goControllerFilePath)
+	if err != nil {
+		return fmt.Errorf("Error formatting controller file: %v", err)
+	}
+
 	return nil
 }
 
 // createTypeScriptTopLevelFiles creates the top level files for a TypeScript handler.
+// A controller that already has a package.json keeps its files.
 func createTypeScriptTopLevelFiles(dirPath, name, description string) error {
+	exists, err := utils.DoesFileExist(path.Join(dirPath, TypeScriptPackageJSONFileName))
+	if err != nil {
+		return fmt.Errorf("Error checking for top level package.json: %v", err)
+	}
+	if exists {
+		return nil
+	}
+
 	// Create the package.json file.
 	packageJSONArgs := PackageJsonArgs{
 		Name:        name,
 		Description: description,
 	}
-	err := writeOutTemplateFile(dirPath, TypeScriptPackageJSONTemplateName, TypeScriptPackageJSONFileName, packageJSONArgs)
+	err = writeOutTemplateFile(dirPath, TypeScriptPackageJSONTemplateName, TypeScriptPackageJSONFileName, packageJSONArgs)
 	if err != nil {
 		return fmt.Errorf("Error creating top level package.json: %v", err)
 	}
@@ -181,26 +347,34 @@ func createTypeScriptTopLevelFiles(dirPath, name, description string) error {
 	return nil
 }
 
+// createTypeScriptFunctionName creates the name of the function a TypeScript
+// controller exports for a method.
+func createTypeScriptFunctionName(name, method string) string {
+	functionNameParts := fmt.Sprintf("%s %s", utils.DashCaseToCamelCase(name), method)
+	return utils.SentenceToCamelCase(functionNameParts)
+}
+
 type TypeScriptControllerFileArgs struct {
 	FunctionName string
 	HandlerName  string
+	Parameters   []RouteParameterArgs
 }
 
 // createNewTypeScriptController creates a new TypeScript controller.
-func createNewTypeScriptController(dirPath, name, method string) error {
+func createNewTypeScriptController(dirPath, name, route, method string) error {
 	// Create the file paths.
 	controllerTemplatePath := path.Join(FileTemplatePath, TypeScriptFileTemplatesDirectoryName, "controller.tmpl")
 	controllerFileName := fmt.Sprintf("%s.ts", strings.ToLower(method))
 	controllerFilePath := path.Join(dirPath, controllerFileName)
 
 	// Create the function and handler names.
-	functionNameParts := fmt.Sprintf("%s %s", utils.DashCaseToCamelCase(name), method)
-	functionName := utils.SentenceToCamelCase(functionNameParts)
+	functionName := createTypeScriptFunctionName(name, method)
 	handlerName := fmt.Sprintf("%sHandler", strings.ToLower(method))
 
 	err := utils.WriteOutTemplateToFile(controllerTemplatePath, controllerFilePath, TypeScriptControllerFileArgs{
 		FunctionName: functionName,
 		HandlerName:  handlerName,
+		Parameters:   routeParameterArgs(route),
 	})
 	if err != nil {
 		return fmt.Errorf("Error writing out controller file for %s method on route %s: %v", method, name, err)
@@ -0,0 +1,133 @@
+package application
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+const (
+	// EventsFolder is the folder in a controller method that holds its event fixtures.
+	EventsFolder = "events"
+
+	// defaultEventHost is the host used for generated events without a Host header.
+	defaultEventHost = "localhost"
+)
+
+// EventOptions describe the request a generated event is for.
+type EventOptions struct {
+	PathParameters        map[string]string
+	QueryStringParameters url.Values
+	Headers               http.Header
+	Body                  string
+	Base64Encode          bool
+}
+
+// createEventPath fills in the path parameters of a route to create the path of
+// a request. Every path parameter of the route must have a value.
+func createEventPath(route string, pathParameters map[string]string) (string, error) {
+	var missingParameters []string
+	for _, parameter := range auto_pulumi.RouteParameters(route) {
+		if _, ok := pathParameters[parameter]; !ok {
+			missingParameters = append(missingParameters, parameter)
+		}
+	}
+	if len(missingParameters) > 0 {
+		return "", fmt.Errorf("route %s is missing values for the path parameters %s, set them with --path-param", route, strings.Join(missingParameters, ", "))
+	}
+
+	eventPath := route
+	for key, value := range pathParameters {
+		eventPath = strings.Replace(eventPath, fmt.Sprintf("{%s+}", key), value, -1)
+		eventPath = strings.Replace(eventPath, fmt.Sprintf("{%s}", key), url.PathEscape(value), -1)
+	}
+
+	return eventPath, nil
+}
+
+// NewRouteEvent creates the API Gateway proxy event a route and method would
+// receive for a request.
+func NewRouteEvent(route auto_pulumi.APIRoute, method string, options EventOptions) (events.APIGatewayProxyRequest, error) {
+	eventPath, err := createEventPath(route.Route, options.PathParameters)
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, err
+	}
+
+	eventURL := url.URL{
+		Path:     eventPath,
+		RawQuery: options.QueryStringParameters.Encode(),
+	}
+
+	// Build the request the same way the local server receives it so the events
+	// match.
+	r, err := http.NewRequest(auto_pulumi.RequestMethod(method), eventURL.String(), strings.NewReader(options.Body))
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, fmt.Errorf("Error creating request: %v", err)
+	}
+	r.Host = defaultEventHost
+	r.RemoteAddr = "127.0.0.1:0"
+	for key, values := range options.Headers {
+		for _, value := range values {
+			r.Header.Add(key, value)
+		}
+	}
+	if host := r.Header.Get("Host"); host != "" {
+		r.Host = host
+		r.Header.Del("Host")
+	}
+
+	request, err := NewAPIGatewayProxyRequest(r, route)
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, err
+	}
+
+	if len(options.PathParameters) > 0 {
+		request.PathParameters = options.PathParameters
+	}
+
+	if options.Base64Encode && !request.IsBase64Encoded {
+		request.Body = base64.StdEncoding.EncodeToString([]byte(options.Body))
+		request.IsBase64Encoded = true
+	}
+
+	return request, nil
+}
+
+// WriteRouteEvent writes an event out to the events folder of a controller method
+// and returns the path of the file.
+func WriteRouteEvent(route auto_pulumi.APIRoute, method string, name string, request events.APIGatewayProxyRequest) (string, error) {
+	eventJSON, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("Error creating event JSON: %v", err)
+	}
+
+	var prettyEventJSON bytes.Buffer
+	err = json.Indent(&prettyEventJSON, eventJSON, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("Error creating event JSON: %v", err)
+	}
+
+	eventsPath := path.Join(route.PathToFiles, strings.ToLower(method), EventsFolder)
+	err = os.MkdirAll(eventsPath, 0755)
+	if err != nil {
+		return "", fmt.Errorf("Error creating events folder: %v", err)
+	}
+
+	fileName := fmt.Sprintf("%s.json", name)
+	err = utils.WriteNewFile(eventsPath, fileName, prettyEventJSON.String()+"\n")
+	if err != nil {
+		return "", fmt.Errorf("Error writing event file: %v", err)
+	}
+
+	return path.Join(eventsPath, fileName), nil
+}
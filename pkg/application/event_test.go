@@ -0,0 +1,39 @@
+package application
+
+import "testing"
+
+func TestCreateEventPath(t *testing.T) {
+	tests := []struct {
+		route          string
+		pathParameters map[string]string
+		want           string
+		wantErr        string
+	}{
+		{route: "/users", want: "/users"},
+		{route: "/users/{id}", pathParameters: map[string]string{"id": "1"}, want: "/users/1"},
+		{route: "/users/{id}", pathParameters: map[string]string{"id": "a b"}, want: "/users/a%20b"},
+		{route: "/files/{path+}", pathParameters: map[string]string{"path": "a/b.txt"}, want: "/files/a/b.txt"},
+		{route: "/users/{id}", wantErr: "route /users/{id} is missing values for the path parameters id, set them with --path-param"},
+		{route: "/users/{id}/posts/{postId}", pathParameters: map[string]string{"postId": "2"}, wantErr: "route /users/{id}/posts/{postId} is missing values for the path parameters id, set them with --path-param"},
+		{route: "/users/{id}/files/{path+}", pathParameters: map[string]string{}, wantErr: "route /users/{id}/files/{path+} is missing values for the path parameters id, path, set them with --path-param"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.route, func(t *testing.T) {
+			got, err := createEventPath(test.route, test.pathParameters)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Fatalf("Got the error %v, want %s", err, test.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error creating the event path: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("Created %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/fatih/color"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/local_services"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// ReadAPIGatewayProxyRequest reads an API Gateway proxy event from a file.
+func ReadAPIGatewayProxyRequest(eventPath string) (events.APIGatewayProxyRequest, error) {
+	eventBytes, err := ioutil.ReadFile(eventPath)
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, fmt.Errorf("Error reading event file %s: %v", eventPath, err)
+	}
+
+	var request events.APIGatewayProxyRequest
+	err = json.Unmarshal(eventBytes, &request)
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, fmt.Errorf("Error parsing event file %s: %v", eventPath, err)
+	}
+
+	return request, nil
+}
+
+// fillInvokeRequestDefaults fills in the parts of an event API Gateway would always
+// set so event files only need the fields a handler cares about.
+func fillInvokeRequestDefaults(request *events.APIGatewayProxyRequest, route auto_pulumi.APIRoute, method string, stage string) {
+	if request.HTTPMethod == "" {
+		request.HTTPMethod = auto_pulumi.RequestMethod(method)
+	}
+	if request.Resource == "" {
+		request.Resource = route.Route
+	}
+	if request.Path == "" {
+		request.Path = route.Route
+	}
+
+	requestContext := &request.RequestContext
+	if requestContext.AccountID == "" {
+		requestContext.AccountID = LocalAccountID
+	}
+	if requestContext.ResourceID == "" {
+		requestContext.ResourceID = route.Name
+	}
+	if requestContext.Stage == "" {
+		requestContext.Stage = stage
+	}
+	if requestContext.RequestID == "" {
+		requestContext.RequestID = createRequestID()
+	}
+	if requestContext.ResourcePath == "" {
+		requestContext.ResourcePath = route.Route
+	}
+	if requestContext.HTTPMethod == "" {
+		requestContext.HTTPMethod = request.HTTPMethod
+	}
+	if requestContext.RequestTimeEpoch == 0 {
+		requestContext.RequestTime = time.Now().UTC().Format("02/Jan/2006:15:04:05 -0700")
+		requestContext.RequestTimeEpoch = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	if requestContext.APIID == "" {
+		requestContext.APIID = LocalStageName
+	}
+}
+
+// InvokeRouteLocally builds a single controller method and invokes it with the
+// event in a file. The stage is the name of the environment if there is one. If
+// services is set the local DynamoDB and S3 are started on a free port for it.
+func InvokeRouteLocally(route auto_pulumi.APIRoute, method string, eventPath string, environment *LocalEnvironment, services bool) (events.APIGatewayProxyResponse, error) {
+	method = strings.ToLower(method)
+
+	// Make sure the route has the method.
+	methods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error reading methods for route %s: %v", route.Name, err)
+	}
+
+	hasMethod := false
+	for _, routeMethod := range methods {
+		if routeMethod == method {
+			hasMethod = true
+		}
+	}
+	if !hasMethod {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Route %s does not have a %s method", route.Name, method)
+	}
+
+	request, err := ReadAPIGatewayProxyRequest(eventPath)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+	stage := LocalStageName
+	if environment.Name != "" {
+		stage = environment.Name
+	}
+	fillInvokeRequestDefaults(&request, route, method, stage)
+
+	// Create the directory for the build.
+	tmp := &utils.TemporaryDirectory{Name: InvokeDirectory}
+	tmp.Clean()
+	err = tmp.Create()
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error creating invoke build directory: %v", err)
+	}
+	defer tmp.Clean()
+
+	// Start the services before the build spinner so it isn't left running if
+	// they fail to start.
+	var localServices *local_services.Server
+	if services {
+		localServices, err = startLocalServices(0, environment)
+		if err != nil {
+			return events.APIGatewayProxyResponse{}, err
+		}
+		defer localServices.Stop()
+	}
+
+	buildSpinner := utils.CreateNewTerminalSpinner(
+		fmt.Sprintf("Building %s %s", strings.ToUpper(method), route.Route),
+		fmt.Sprintf("Built %s %s.", strings.ToUpper(method), route.Route),
+		fmt.Sprintf("Failed to build %s %s.", strings.ToUpper(method), route.Route),
+	)
+
+	builder := NewLocalHandlerBuilder(tmp.Name)
+	builder.Environment = handlerEnvironment(localServices, environment)
+	handler, err := builder.Build(route, method)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, buildSpinner.FailWithMessage(fmt.Sprintf("Error building %s method on route %s", method, route.Name), err)
+	}
+	buildSpinner.Stop()
+	defer handler.Stop()
+
+	response, err := handler.Invoke(request)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error invoking %s method on route %s: %v", method, route.Name, err)
+	}
+
+	return response, nil
+}
+
+// formatResponseBody decodes a response body and pretty prints it if it is JSON.
+func formatResponseBody(response events.APIGatewayProxyResponse) string {
+	body := []byte(response.Body)
+	if response.IsBase64Encoded {
+		decodedBody, err := base64.StdEncoding.DecodeString(response.Body)
+		if err != nil {
+			return response.Body
+		}
+		body = decodedBody
+	}
+
+	var prettyBody bytes.Buffer
+	err := json.Indent(&prettyBody, body, "", "  ")
+	if err != nil {
+		return string(body)
+	}
+
+	return prettyBody.String()
+}
+
+// PrintAPIGatewayProxyResponse prints the status code, headers, and body of a response.
+func PrintAPIGatewayProxyResponse(response events.APIGatewayProxyResponse) {
+	utils.Print(utils.TextColor(fmt.Sprintf("Status: %d", response.StatusCode), color.Bold))
+
+	// Combine the headers the same way they are written out by the local server.
+	headers := make(map[string][]string)
+	for key, values := range response.MultiValueHeaders {
+		headers[key] = append(headers[key], values...)
+	}
+	for key, value := range response.Headers {
+		headers[key] = []string{value}
+	}
+
+	if len(headers) > 0 {
+		utils.Print(utils.TextColor("Headers:", color.Bold))
+
+		var keys []string
+		for key := range headers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, value := range headers[key] {
+				utils.Printf("  %s: %s\n", key, value)
+			}
+		}
+	}
+
+	utils.Print(utils.TextColor("Body:", color.Bold))
+	utils.Print(formatResponseBody(response))
+}
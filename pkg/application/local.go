@@ -2,12 +2,462 @@ package application
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/local_services"
+	"github.com/zchase/stevie/pkg/utils"
 )
 
-// RunTypeScriptRoutesLocally runs the API routes locally.
-func RunTypeScriptRoutesLocally(routes []auto_pulumi.APIRoute, port int) error {
-	fmt.Println("Not implemented")
+// LocalRoute is a controller method served by the local server.
+type LocalRoute struct {
+	Route   auto_pulumi.APIRoute
+	Method  string
+	Handler LocalHandler
+}
+
+// LocalServer emulates API Gateway by turning HTTP requests into API Gateway proxy
+// events and sending them to the controller for the route.
+type LocalServer struct {
+	Routes []LocalRoute
+
+	// Fallback handles requests for paths that don't have a local route.
+	Fallback http.Handler
+
+	builder *LocalHandlerBuilder
+	mutex   sync.RWMutex
+}
+
+// NewLocalServer builds the controllers for the routes and creates a server for them.
+// The handlers are run with the environment variables given for each of them.
+func NewLocalServer(buildDirectory string, routes []auto_pulumi.APIRoute, environment HandlerEnvironment) (*LocalServer, error) {
+	server := &LocalServer{
+		builder: NewLocalHandlerBuilder(buildDirectory),
+	}
+	server.builder.Environment = environment
+
+	for _, route := range routes {
+		methods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+		if err != nil {
+			server.Stop()
+			return nil, fmt.Errorf("Error reading methods for route %s: %v", route.Name, err)
+		}
+
+		for _, method := range methods {
+			err = server.buildRouteMethod(route, method)
+			if err != nil {
+				server.Stop()
+				return nil, err
+			}
+		}
+	}
+
+	return server, nil
+}
+
+// buildRouteMethod builds the handler for a method on a route and swaps it in for
+// any handler already serving it.
+func (s *LocalServer) buildRouteMethod(route auto_pulumi.APIRoute, method string) error {
+	buildSpinner := utils.CreateNewTerminalSpinner(
+		fmt.Sprintf("Building %s %s", strings.ToUpper(method), route.Route),
+		fmt.Sprintf("Built %s %s.", strings.ToUpper(method), route.Route),
+		fmt.Sprintf("Failed to build %s %s.", strings.ToUpper(method), route.Route),
+	)
+
+	handler, err := s.builder.Build(route, method)
+	if err != nil {
+		return buildSpinner.FailWithMessage(fmt.Sprintf("Error building %s method on route %s", method, route.Name), err)
+	}
+	buildSpinner.Stop()
+
+	s.setRouteHandler(LocalRoute{
+		Route:   route,
+		Method:  method,
+		Handler: handler,
+	})
 	return nil
 }
+
+// setRouteHandler adds a route handler to the server, replacing and stopping the
+// existing handler for the same route and method. The old handler is stopped in the
+// background so it can finish the requests it is running.
+func (s *LocalServer) setRouteHandler(localRoute LocalRoute) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i, existingRoute := range s.Routes {
+		if existingRoute.Route.Name == localRoute.Route.Name && existingRoute.Method == localRoute.Method {
+			go existingRoute.Handler.Stop()
+			s.Routes[i] = localRoute
+			return
+		}
+	}
+
+	s.Routes = append(s.Routes, localRoute)
+}
+
+// removeRouteHandlers removes and stops the handlers that match a filter. Like
+// setRouteHandler, the handlers finish their requests in the background.
+func (s *LocalServer) removeRouteHandlers(shouldRemove func(localRoute LocalRoute) bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var remainingRoutes []LocalRoute
+	for _, localRoute := range s.Routes {
+		if shouldRemove(localRoute) {
+			utils.Printf("Removed %s %s.\n", strings.ToUpper(localRoute.Method), localRoute.Route.Route)
+			go localRoute.Handler.Stop()
+			continue
+		}
+
+		remainingRoutes = append(remainingRoutes, localRoute)
+	}
+	s.Routes = remainingRoutes
+}
+
+// servedMethods returns the methods currently served for a route.
+func (s *LocalServer) servedMethods(routeName string) map[string]bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	methods := make(map[string]bool)
+	for _, localRoute := range s.Routes {
+		if localRoute.Route.Name == routeName {
+			methods[localRoute.Method] = true
+		}
+	}
+
+	return methods
+}
+
+// matchRoutePath matches a request path against a route path and returns the values
+// of the route's path parameters.
+func matchRoutePath(route, requestPath string) (map[string]string, bool) {
+	routeSegments := auto_pulumi.RouteSegments(route)
+	requestSegments := auto_pulumi.RouteSegments(requestPath)
+
+	pathParameters := make(map[string]string)
+	for i, segment := range routeSegments {
+		if i >= len(requestSegments) {
+			return nil, false
+		}
+
+		parameter, greedy := auto_pulumi.RouteParameter(segment)
+		switch {
+		case parameter == "":
+			if segment != requestSegments[i] {
+				return nil, false
+			}
+		case greedy:
+			pathParameters[parameter] = strings.Join(requestSegments[i:], "/")
+			return pathParameters, true
+		default:
+			pathParameters[parameter] = requestSegments[i]
+		}
+	}
+
+	if len(routeSegments) != len(requestSegments) {
+		return nil, false
+	}
+
+	return pathParameters, true
+}
+
+// segmentSpecificity ranks how specific a route segment is. Like API Gateway, plain
+// segments win over path parameters and path parameters win over greedy ones.
+func segmentSpecificity(segment string) int {
+	parameter, greedy := auto_pulumi.RouteParameter(segment)
+	switch {
+	case parameter == "":
+		return 2
+	case !greedy:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// isMoreSpecificRoute checks if a route path is more specific than another. The
+// segments are compared from the left and the first one that differs decides, so
+// /b/{c}/{d} is more specific than /{a}/x/y.
+func isMoreSpecificRoute(route, other string) bool {
+	segments := auto_pulumi.RouteSegments(route)
+	otherSegments := auto_pulumi.RouteSegments(other)
+	for i := 0; i < len(segments) && i < len(otherSegments); i++ {
+		specificity := segmentSpecificity(segments[i])
+		otherSpecificity := segmentSpecificity(otherSegments[i])
+		if specificity != otherSpecificity {
+			return specificity > otherSpecificity
+		}
+	}
+
+	return len(segments) > len(otherSegments)
+}
+
+// findRoutePath finds the most specific route path that matches a request path.
+// The lock must be held by the caller.
+func (s *LocalServer) findRoutePath(requestPath string) (string, bool) {
+	bestRoute := ""
+	found := false
+	for _, route := range s.Routes {
+		if _, ok := matchRoutePath(route.Route.Route, requestPath); !ok {
+			continue
+		}
+
+		if !found || isMoreSpecificRoute(route.Route.Route, bestRoute) {
+			bestRoute = route.Route.Route
+			found = true
+		}
+	}
+
+	return bestRoute, found
+}
+
+// findRouteMethod finds the route that serves a method at a request path.
+func (s *LocalServer) findRouteMethod(r *http.Request, method string) (LocalRoute, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	routePath, ok := s.findRoutePath(r.URL.Path)
+	if !ok {
+		return LocalRoute{}, false
+	}
+
+	for _, route := range s.Routes {
+		if route.Route.Route == routePath && strings.EqualFold(route.Method, method) {
+			return route, true
+		}
+	}
+
+	return LocalRoute{}, false
+}
+
+// findRoute finds the route that handles a request. A handler for the request's
+// method wins over the route's catch-all handler.
+func (s *LocalServer) findRoute(r *http.Request) (LocalRoute, bool) {
+	route, ok := s.findRouteMethod(r, r.Method)
+	if !ok {
+		route, ok = s.findRouteMethod(r, auto_pulumi.AnyMethod)
+	}
+
+	return route, ok
+}
+
+// logLocalRequest logs a request handled by the local server.
+func logLocalRequest(r *http.Request, statusCode int, start time.Time) {
+	statusColor := color.FgGreen
+	switch {
+	case statusCode >= 500:
+		statusColor = color.FgRed
+	case statusCode >= 400:
+		statusColor = color.FgYellow
+	case statusCode >= 300:
+		statusColor = color.FgCyan
+	}
+
+	duration := float64(time.Since(start).Microseconds()) / 1000
+	utils.Printf("%s %s %s %.3f ms\n", r.Method, r.URL.Path, utils.TextColor(fmt.Sprintf("%d", statusCode), statusColor), duration)
+}
+
+// ServeHTTP handles a request to the local server.
+func (s *LocalServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	// CORS enabled routes answer preflight requests without calling a handler.
+	if _, ok := s.findPreflightRoute(r); ok {
+		writeCORSPreflightResponse(w)
+		logLocalRequest(r, http.StatusOK, start)
+		return
+	}
+
+	// Paths that aren't served locally go to the fallback if there is one.
+	if s.Fallback != nil && !s.hasRoutePath(r) {
+		s.Fallback.ServeHTTP(w, r)
+		return
+	}
+
+	// API Gateway responds to unknown routes and methods with a 403. This includes
+	// preflight requests to routes without CORS enabled.
+	route, ok := s.findRoute(r)
+	if !ok {
+		writeAPIGatewayError(w, http.StatusForbidden, "Missing Authentication Token")
+		logLocalRequest(r, http.StatusForbidden, start)
+		return
+	}
+
+	request, err := NewAPIGatewayProxyRequest(r, route.Route)
+	if err != nil {
+		utils.Printf("Error creating API Gateway event: %v\n", err)
+		writeAPIGatewayError(w, http.StatusBadRequest, "Bad Request")
+		logLocalRequest(r, http.StatusBadRequest, start)
+		return
+	}
+
+	// A handler stopped while the request was on its way to it has been replaced
+	// or removed, so the request goes to whatever serves the route now.
+	response, err := route.Handler.Invoke(request)
+	for err == errLocalHandlerStopped {
+		route, ok = s.findRoute(r)
+		if !ok {
+			writeAPIGatewayError(w, http.StatusForbidden, "Missing Authentication Token")
+			logLocalRequest(r, http.StatusForbidden, start)
+			return
+		}
+		response, err = route.Handler.Invoke(request)
+	}
+
+	// Errors from the handler surface as a 502 like they do in API Gateway.
+	if err != nil {
+		utils.Printf("Error invoking %s method on route %s: %v\n", route.Method, route.Route.Name, err)
+		writeAPIGatewayError(w, http.StatusBadGateway, "Internal server error")
+		logLocalRequest(r, http.StatusBadGateway, start)
+		return
+	}
+
+	warnAboutCrossOriginRequest(r, route, response)
+
+	err = WriteAPIGatewayProxyResponse(w, response)
+	if err != nil {
+		utils.Printf("Error writing response for %s method on route %s: %v\n", route.Method, route.Route.Name, err)
+		writeAPIGatewayError(w, http.StatusBadGateway, "Internal server error")
+		logLocalRequest(r, http.StatusBadGateway, start)
+		return
+	}
+
+	logLocalRequest(r, response.StatusCode, start)
+}
+
+// Stop stops the handlers for all the routes.
+func (s *LocalServer) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, route := range s.Routes {
+		route.Handler.Stop()
+	}
+	s.Routes = nil
+}
+
+// LocalServerOptions configure how the routes are run locally.
+type LocalServerOptions struct {
+	Port        int
+	Watch       bool
+	LoadRoutes  func() ([]auto_pulumi.APIRoute, error)
+	Environment *LocalEnvironment
+
+	// ConfigFilePaths are the config files the routes are loaded from. The routes
+	// are reloaded when any of them change.
+	ConfigFilePaths []string
+
+	// RecordDirectory is where requests are saved as fixtures. Nothing is
+	// recorded if it is empty.
+	RecordDirectory string
+
+	// Only are the names of the routes to serve. Every route is served if it
+	// is empty.
+	Only []string
+
+	// FallbackURL is the stage URL of a deployed API that requests for the
+	// other routes are forwarded to.
+	FallbackURL string
+
+	// Services starts the local DynamoDB and S3 on the services port.
+	Services     bool
+	ServicesPort int
+}
+
+// RunRoutesLocally builds the API routes and serves them locally.
+func RunRoutesLocally(options LocalServerOptions) error {
+	// Only load the chosen routes, including when the config is reloaded.
+	loadRoutes := func() ([]auto_pulumi.APIRoute, error) {
+		routes, err := options.LoadRoutes()
+		if err != nil {
+			return nil, err
+		}
+
+		return filterRoutes(routes, options.Only)
+	}
+
+	routes, err := loadRoutes()
+	if err != nil {
+		return fmt.Errorf("Error loading routes: %v", err)
+	}
+
+	var fallback *localFallbackProxy
+	if options.FallbackURL != "" {
+		fallback, err = newLocalFallbackProxy(options.FallbackURL)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create the directory for the local builds, removing anything left over
+	// from a previous run.
+	tmp := &utils.TemporaryDirectory{Name: LocalServerDirectory}
+	tmp.Clean()
+	err = tmp.Create()
+	if err != nil {
+		return fmt.Errorf("Error creating local build directory: %v", err)
+	}
+
+	var services *local_services.Server
+	if options.Services {
+		services, err = startLocalServices(options.ServicesPort, options.Environment)
+		if err != nil {
+			tmp.Clean()
+			return err
+		}
+	}
+
+	options.Environment.Print()
+	server, err := NewLocalServer(tmp.Name, routes, handlerEnvironment(services, options.Environment))
+	if err != nil {
+		if services != nil {
+			services.Stop()
+		}
+		tmp.Clean()
+		return err
+	}
+
+	if fallback != nil {
+		utils.Printf("Forwarding other routes to %s\n", options.FallbackURL)
+		server.Fallback = fallback
+	}
+
+	// Stop the handlers and clean up the builds when the server is closed.
+	cleanUp := func() {
+		server.Stop()
+		if services != nil {
+			services.Stop()
+		}
+		tmp.Clean()
+	}
+	utils.ListenForProgramClose(cleanUp)
+
+	// Rebuild the routes as their files change.
+	if options.Watch {
+		reloader := &localServerReloader{
+			Server:          server,
+			ConfigFilePaths: options.ConfigFilePaths,
+			LoadRoutes:      loadRoutes,
+			Routes:          routes,
+		}
+		go reloader.Watch()
+	}
+
+	// Record the requests if there is somewhere to put them.
+	var handler http.Handler = server
+	if options.RecordDirectory != "" {
+		utils.Printf("Recording requests to %s\n", options.RecordDirectory)
+		handler = newLocalRecorder(server, options.RecordDirectory)
+	}
+
+	utils.Printf("Server is listening at http://localhost:%d\n", options.Port)
+	err = http.ListenAndServe(fmt.Sprintf(":%d", options.Port), handler)
+	cleanUp()
+	return err
+}
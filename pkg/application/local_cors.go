@@ -0,0 +1,120 @@
+package application
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// LocalCORSHeaders are the headers the deployed gateway responds to a CORS
+// preflight request with.
+var LocalCORSHeaders = map[string]string{
+	"Access-Control-Allow-Origin":  "*",
+	"Access-Control-Allow-Methods": "*",
+	"Access-Control-Allow-Headers": "*",
+}
+
+// isCrossOriginRequest checks if a request was made from a different origin than
+// the local server.
+func isCrossOriginRequest(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return true
+	}
+
+	return originURL.Host != r.Host
+}
+
+// findCORSRoute finds a CORS enabled route for a request path.
+func (s *LocalServer) findCORSRoute(r *http.Request) (LocalRoute, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	routePath, ok := s.findRoutePath(r.URL.Path)
+	if !ok {
+		return LocalRoute{}, false
+	}
+
+	for _, route := range s.Routes {
+		if route.Route.CorsEnabled && route.Route.Route == routePath {
+			return route, true
+		}
+	}
+
+	return LocalRoute{}, false
+}
+
+// findPreflightRoute finds the CORS enabled route that answers a preflight request.
+// Routes with their own OPTIONS handler answer preflight requests themselves.
+func (s *LocalServer) findPreflightRoute(r *http.Request) (LocalRoute, bool) {
+	if r.Method != http.MethodOptions {
+		return LocalRoute{}, false
+	}
+
+	if _, ok := s.findRouteMethod(r, http.MethodOptions); ok {
+		return LocalRoute{}, false
+	}
+
+	return s.findCORSRoute(r)
+}
+
+// writeCORSPreflightResponse responds to a preflight request the same way the
+// MOCK integration on the deployed gateway does.
+func writeCORSPreflightResponse(w http.ResponseWriter) {
+	for key, value := range LocalCORSHeaders {
+		w.Header().Set(key, value)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("{}"))
+}
+
+// hasResponseHeader checks if a response sets a header.
+func hasResponseHeader(response events.APIGatewayProxyResponse, header string) bool {
+	for key := range response.Headers {
+		if strings.EqualFold(key, header) {
+			return true
+		}
+	}
+
+	for key := range response.MultiValueHeaders {
+		if strings.EqualFold(key, header) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// warnAboutCrossOriginRequest warns when a cross-origin request would be blocked
+// by the browser once the API is deployed.
+func warnAboutCrossOriginRequest(r *http.Request, route LocalRoute, response events.APIGatewayProxyResponse) {
+	if !isCrossOriginRequest(r) {
+		return
+	}
+
+	if !route.Route.CorsEnabled {
+		utils.Printf(
+			"Warning: cross-origin request from %s to route %s which does not have CORS enabled. Browsers will block this request.\n",
+			r.Header.Get("Origin"), route.Route.Name,
+		)
+		return
+	}
+
+	// The gateway only adds the CORS headers to the preflight response so the
+	// handler has to return them for everything else.
+	if !hasResponseHeader(response, "Access-Control-Allow-Origin") {
+		utils.Printf(
+			"Warning: the %s method on route %s does not return an Access-Control-Allow-Origin header. Browsers will block this request.\n",
+			route.Method, route.Route.Name,
+		)
+	}
+}
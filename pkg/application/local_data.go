@@ -0,0 +1,38 @@
+package application
+
+import (
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/local_services"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// startLocalServices starts the local DynamoDB and S3 on a port, in the region of
+// the environment. Their data is kept in the local data directory between runs.
+func startLocalServices(port int, environment *LocalEnvironment) (*local_services.Server, error) {
+	services, err := local_services.NewServer(LocalDataDirectory, port, environment.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = services.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Printf("Local DynamoDB and S3 are listening at %s\n", services.Endpoint())
+	return services, nil
+}
+
+// handlerEnvironment returns the variables the handlers are run with. The
+// variables pointing the AWS SDKs at the local services come first so the
+// environment's own variables can override them.
+func handlerEnvironment(services *local_services.Server, environment *LocalEnvironment) HandlerEnvironment {
+	return func(route auto_pulumi.APIRoute, method string) []string {
+		var environ []string
+		if services != nil {
+			environ = append(environ, services.Environ()...)
+		}
+
+		return append(environ, environment.Environ(route, method)...)
+	}
+}
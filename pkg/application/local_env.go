@@ -0,0 +1,140 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// LocalEnvFilePrefix is the prefix of the git-ignored files that hold the secrets
+// for an environment, e.g. .env.development.
+const LocalEnvFilePrefix = ".env"
+
+// LocalEnvironment holds the variables handlers get when they are run locally.
+type LocalEnvironment struct {
+	Name    string
+	Project string
+
+	// Region is the AWS region of the environment.
+	Region string
+
+	// Variables are the project and environment variables and the secrets from
+	// the env file.
+	Variables map[string]string
+
+	// secrets are the names of the variables that came from the env file.
+	secrets map[string]bool
+}
+
+// LocalEnvFileName returns the name of the env file for an environment.
+func LocalEnvFileName(env string) string {
+	return fmt.Sprintf("%s.%s", LocalEnvFilePrefix, env)
+}
+
+// LoadLocalEnvironment reads the variables for an environment from the config and
+// its optional env file. Values in the env file override the config.
+func LoadLocalEnvironment(configPath string, env string) (*LocalEnvironment, error) {
+	environment := &LocalEnvironment{
+		Name:      env,
+		Variables: make(map[string]string),
+		secrets:   make(map[string]bool),
+	}
+
+	// Make sure the environment exists.
+	if env != "" {
+		_, err := config.LoadEnvironment(configPath, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projectConfig, err := config.LoadProjectForEnvironment(configPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	environment.Project = projectConfig.DashCaseName
+	environment.Region = projectConfig.AWS.WithDefaults().Region
+	for key, value := range projectConfig.Variables {
+		environment.Variables[key] = value
+	}
+
+	if env == "" {
+		return environment, nil
+	}
+
+	envFileName := LocalEnvFileName(env)
+	envFileExists, err := utils.DoesFileExist(envFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if envFileExists {
+		secrets, err := utils.ReadEnvFile(envFileName)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range secrets {
+			environment.Variables[key] = value
+			environment.secrets[key] = true
+		}
+	}
+
+	return environment, nil
+}
+
+// Environ returns the variables for a method of a route in KEY=VALUE form for a
+// process. They are layered the same way as for a deployed function.
+func (e *LocalEnvironment) Environ(route auto_pulumi.APIRoute, method string) []string {
+	stage := e.Name
+	if stage == "" {
+		stage = LocalStageName
+	}
+
+	configVariables := make(map[string]string)
+	for key, value := range e.Variables {
+		if !e.secrets[key] {
+			configVariables[key] = value
+		}
+	}
+
+	variables := auto_pulumi.FunctionVariables(e.Project, stage, configVariables, route, method)
+	for key := range e.secrets {
+		variables[key] = e.Variables[key]
+	}
+
+	var environ []string
+	for key, value := range variables {
+		environ = append(environ, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(environ)
+
+	return environ
+}
+
+// Print prints out the variables, masking the secrets.
+func (e *LocalEnvironment) Print() {
+	if len(e.Variables) == 0 {
+		return
+	}
+
+	var keys []string
+	for key := range e.Variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	utils.Printf("Environment variables for %s:\n", e.Name)
+	for _, key := range keys {
+		value := e.Variables[key]
+		if e.secrets[key] {
+			value = strings.Repeat("*", 8)
+		}
+		utils.Printf("  %s=%s\n", key, value)
+	}
+}
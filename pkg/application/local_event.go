@@ -0,0 +1,158 @@
+package application
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+)
+
+var (
+	// LocalStageName is the API Gateway stage name used for local requests.
+	LocalStageName = "local"
+
+	// LocalAccountID is the AWS account ID used for local requests.
+	LocalAccountID = "000000000000"
+)
+
+// createRequestID creates a random ID for a local request.
+func createRequestID() string {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// encodeRequestBody encodes a request body the way API Gateway does. Text bodies
+// are passed through and anything else is base64 encoded.
+func encodeRequestBody(body []byte) (string, bool) {
+	if utf8.Valid(body) {
+		return string(body), false
+	}
+
+	return base64.StdEncoding.EncodeToString(body), true
+}
+
+// NewAPIGatewayProxyRequest converts an HTTP request for a route into an API Gateway
+// proxy event.
+func NewAPIGatewayProxyRequest(r *http.Request, route auto_pulumi.APIRoute) (events.APIGatewayProxyRequest, error) {
+	// Read the body.
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return events.APIGatewayProxyRequest{}, fmt.Errorf("Error reading request body: %v", err)
+	}
+	encodedBody, isBase64Encoded := encodeRequestBody(body)
+
+	// API Gateway passes along the last value of a header or query string
+	// parameter in the single value maps.
+	headers := map[string]string{"Host": r.Host}
+	multiValueHeaders := map[string][]string{"Host": {r.Host}}
+	for key, values := range r.Header {
+		headers[key] = values[len(values)-1]
+		multiValueHeaders[key] = values
+	}
+
+	var queryStringParameters map[string]string
+	var multiValueQueryStringParameters map[string][]string
+	query := r.URL.Query()
+	if len(query) > 0 {
+		queryStringParameters = make(map[string]string)
+		multiValueQueryStringParameters = make(map[string][]string)
+		for key, values := range query {
+			queryStringParameters[key] = values[len(values)-1]
+			multiValueQueryStringParameters[key] = values
+		}
+	}
+
+	// API Gateway leaves out the path parameters for routes without any.
+	var pathParameters map[string]string
+	if values, ok := matchRoutePath(route.Route, r.URL.Path); ok && len(values) > 0 {
+		pathParameters = values
+	}
+
+	// Get the IP of the caller.
+	sourceIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		sourceIP = r.RemoteAddr
+	}
+
+	return events.APIGatewayProxyRequest{
+		Resource:                        route.Route,
+		Path:                            r.URL.Path,
+		HTTPMethod:                      r.Method,
+		Headers:                         headers,
+		MultiValueHeaders:               multiValueHeaders,
+		QueryStringParameters:           queryStringParameters,
+		MultiValueQueryStringParameters: multiValueQueryStringParameters,
+		PathParameters:                  pathParameters,
+		RequestContext: events.APIGatewayProxyRequestContext{
+			AccountID:        LocalAccountID,
+			ResourceID:       route.Name,
+			Stage:            LocalStageName,
+			DomainName:       r.Host,
+			RequestID:        createRequestID(),
+			ResourcePath:     route.Route,
+			HTTPMethod:       r.Method,
+			RequestTime:      time.Now().UTC().Format("02/Jan/2006:15:04:05 -0700"),
+			RequestTimeEpoch: time.Now().UnixNano() / int64(time.Millisecond),
+			APIID:            LocalStageName,
+			Protocol:         r.Proto,
+			Identity: events.APIGatewayRequestIdentity{
+				SourceIP:  sourceIP,
+				UserAgent: r.UserAgent(),
+			},
+		},
+		Body:            encodedBody,
+		IsBase64Encoded: isBase64Encoded,
+	}, nil
+}
+
+// WriteAPIGatewayProxyResponse writes an API Gateway proxy response out to an
+// HTTP response.
+func WriteAPIGatewayProxyResponse(w http.ResponseWriter, response events.APIGatewayProxyResponse) error {
+	// API Gateway rejects responses without a status code.
+	if response.StatusCode == 0 {
+		return fmt.Errorf("Malformed Lambda proxy response: missing status code")
+	}
+
+	body := []byte(response.Body)
+	if response.IsBase64Encoded {
+		decodedBody, err := base64.StdEncoding.DecodeString(response.Body)
+		if err != nil {
+			return fmt.Errorf("Malformed Lambda proxy response: %v", err)
+		}
+		body = decodedBody
+	}
+
+	for key, values := range response.MultiValueHeaders {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	for key, value := range response.Headers {
+		w.Header().Set(key, value)
+	}
+
+	w.WriteHeader(response.StatusCode)
+	_, err := w.Write(body)
+	return err
+}
+
+// writeAPIGatewayError writes out an error response the way API Gateway does.
+func writeAPIGatewayError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	fmt.Fprintf(w, "{\"message\":%q}", message)
+}
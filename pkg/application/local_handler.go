@@ -0,0 +1,433 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda/messages"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+var (
+	// LocalHandlerTimeout is how long a local invocation can run. It matches the
+	// API Gateway integration timeout.
+	LocalHandlerTimeout = 29 * time.Second
+
+	// localHandlerStartTimeout is how long to wait for a Go handler to start.
+	localHandlerStartTimeout = 10 * time.Second
+
+	// errLocalHandlerStopped is returned for requests that reach a handler after it
+	// was stopped.
+	errLocalHandlerStopped = errors.New("Handler was stopped")
+)
+
+// LocalHandler runs a controller method locally.
+type LocalHandler interface {
+	Invoke(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	Stop()
+}
+
+// drainingLocalHandler wraps a handler so stopping it waits for the requests it is
+// running to finish. Its build directory is removed once it has stopped.
+type drainingLocalHandler struct {
+	handler   LocalHandler
+	directory string
+
+	mutex   sync.RWMutex
+	stopped bool
+}
+
+// newDrainingLocalHandler wraps a handler that runs from a build directory. The
+// directory can be empty if the handler doesn't have one.
+func newDrainingLocalHandler(handler LocalHandler, directory string) *drainingLocalHandler {
+	return &drainingLocalHandler{handler: handler, directory: directory}
+}
+
+// Invoke invokes the handler with a request unless it has been stopped.
+func (h *drainingLocalHandler) Invoke(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	if h.stopped {
+		return events.APIGatewayProxyResponse{}, errLocalHandlerStopped
+	}
+
+	return h.handler.Invoke(request)
+}
+
+// Stop waits for the running requests to finish, then stops the handler and removes
+// its build directory.
+func (h *drainingLocalHandler) Stop() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.stopped {
+		return
+	}
+	h.stopped = true
+
+	h.handler.Stop()
+	if h.directory != "" {
+		os.RemoveAll(h.directory)
+	}
+}
+
+// goLocalHandler runs a compiled Go controller and talks to it over the
+// Lambda RPC protocol.
+type goLocalHandler struct {
+	process *exec.Cmd
+	client  *rpc.Client
+}
+
+// Invoke invokes the Go handler with a request.
+func (h *goLocalHandler) Invoke(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+
+	deadline := time.Now().Add(LocalHandlerTimeout)
+	invokeRequest := &messages.InvokeRequest{
+		Payload:   payload,
+		RequestId: request.RequestContext.RequestID,
+		Deadline: messages.InvokeRequest_Timestamp{
+			Seconds: deadline.Unix(),
+			Nanos:   int64(deadline.Nanosecond()),
+		},
+	}
+
+	// Make the call and wait for it to finish or time out.
+	var invokeResponse messages.InvokeResponse
+	call := h.client.Go("Function.Invoke", invokeRequest, &invokeResponse, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		if call.Error != nil {
+			return events.APIGatewayProxyResponse{}, call.Error
+		}
+	case <-time.After(LocalHandlerTimeout):
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Task timed out after %s", LocalHandlerTimeout)
+	}
+
+	if invokeResponse.Error != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("%s: %s", invokeResponse.Error.Type, invokeResponse.Error.Message)
+	}
+
+	var response events.APIGatewayProxyResponse
+	err = json.Unmarshal(invokeResponse.Payload, &response)
+	return response, err
+}
+
+// Stop stops the Go handler process.
+func (h *goLocalHandler) Stop() {
+	h.client.Close()
+	h.process.Process.Kill()
+	h.process.Wait()
+}
+
+// processLocalHandler runs a controller by starting a process for each request. The
+// request is written to the process' stdin and the process writes the response to the
+// file passed as its last argument.
+type processLocalHandler struct {
+	command       string
+	args          []string
+	environment   []string
+	resultDirPath string
+}
+
+// Invoke invokes the handler process with a request.
+func (h *processLocalHandler) Invoke(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+
+	// Create the file the process writes the result to.
+	resultFile, err := ioutil.TempFile(h.resultDirPath, "result-*.json")
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error creating result file: %v", err)
+	}
+	resultFile.Close()
+	defer os.Remove(resultFile.Name())
+
+	ctx, cancel := context.WithTimeout(context.Background(), LocalHandlerTimeout)
+	defer cancel()
+
+	args := append(append([]string{}, h.args...), resultFile.Name())
+	cmd := exec.CommandContext(ctx, h.command, args...)
+	cmd.Env = append(os.Environ(), h.environment...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Task timed out after %s", LocalHandlerTimeout)
+	}
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error running handler: %v", err)
+	}
+
+	result, err := ioutil.ReadFile(resultFile.Name())
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, fmt.Errorf("Error reading handler result: %v", err)
+	}
+
+	var response events.APIGatewayProxyResponse
+	err = json.Unmarshal(result, &response)
+	return response, err
+}
+
+// Stop is a no-op since the process only lives for a single request.
+func (h *processLocalHandler) Stop() {}
+
+// getFreePort finds an open port on localhost.
+func getFreePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// startGoLocalHandler starts a compiled Go handler with extra environment variables
+// and connects to it.
+func startGoLocalHandler(binaryPath string, environment []string) (*goLocalHandler, error) {
+	port, err := getFreePort()
+	if err != nil {
+		return nil, fmt.Errorf("Error finding a port for the handler: %v", err)
+	}
+
+	process := exec.Command(binaryPath)
+	process.Env = append(append(os.Environ(), environment...), fmt.Sprintf("_LAMBDA_SERVER_PORT=%d", port))
+	process.Stdout = os.Stdout
+	process.Stderr = os.Stderr
+	err = process.Start()
+	if err != nil {
+		return nil, fmt.Errorf("Error starting handler: %v", err)
+	}
+
+	// Wait for the handler to start listening.
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+	startDeadline := time.Now().Add(localHandlerStartTimeout)
+	for {
+		client, err := rpc.Dial("tcp", address)
+		if err == nil {
+			return &goLocalHandler{process: process, client: client}, nil
+		}
+
+		if time.Now().After(startDeadline) {
+			process.Process.Kill()
+			process.Wait()
+			return nil, fmt.Errorf("Handler did not start listening within %s", localHandlerStartTimeout)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// HandlerEnvironment returns the extra KEY=VALUE environment variables for the
+// handler of a method of a route.
+type HandlerEnvironment func(route auto_pulumi.APIRoute, method string) []string
+
+// LocalHandlerBuilder builds controller methods so they can be run locally.
+type LocalHandlerBuilder struct {
+	BuildDirectory string
+
+	// Environment gives the environment variables for each handler. The handlers
+	// only get the variables of the process if it is nil.
+	Environment HandlerEnvironment
+
+	// builtControllers tracks the TypeScript controllers that have been built
+	// since the whole controller is built at once.
+	builtControllers map[string]bool
+}
+
+// NewLocalHandlerBuilder creates a builder that puts its output in a build directory.
+func NewLocalHandlerBuilder(buildDirectory string) *LocalHandlerBuilder {
+	return &LocalHandlerBuilder{
+		BuildDirectory:   buildDirectory,
+		builtControllers: make(map[string]bool),
+	}
+}
+
+// Invalidate marks a controller as changed so it is built again.
+func (b *LocalHandlerBuilder) Invalidate(controllerPath string) {
+	delete(b.builtControllers, controllerPath)
+}
+
+// environment returns the extra environment variables for a handler.
+func (b *LocalHandlerBuilder) environment(route auto_pulumi.APIRoute, method string) []string {
+	if b.Environment == nil {
+		return nil
+	}
+
+	return b.Environment(route, method)
+}
+
+// Build builds a controller method and returns a handler for running it.
+func (b *LocalHandlerBuilder) Build(route auto_pulumi.APIRoute, method string) (LocalHandler, error) {
+	methodPath := path.Join(route.PathToFiles, method)
+	language, err := auto_pulumi.DetectLambdaLanguage(methodPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error detecting language for %s method on route %s: %v", method, route.Name, err)
+	}
+
+	switch language {
+	case GoControllerLanguage:
+		return b.buildGoHandler(route, method)
+	case TypeScriptControllerLanguage:
+		return b.buildTypeScriptHandler(route, method)
+	case DotNetControllerLanguage:
+		return b.buildDotNetHandler(route, method)
+	default:
+		return nil, fmt.Errorf("Language not supported: %s", language)
+	}
+}
+
+// handlerDirectory creates a new build directory for a controller method. Each build
+// gets its own directory so the handler it replaces can keep running from the old one
+// until its requests finish.
+func (b *LocalHandlerBuilder) handlerDirectory(route auto_pulumi.APIRoute, method string) (string, string, error) {
+	name := fmt.Sprintf("%s-%s-handler", route.Name, method)
+	dirPath, err := ioutil.TempDir(b.BuildDirectory, fmt.Sprintf("%s-", name))
+	if err != nil {
+		return "", "", err
+	}
+
+	return name, dirPath, nil
+}
+
+// buildGoHandler compiles a Go controller method for the current platform.
+func (b *LocalHandlerBuilder) buildGoHandler(route auto_pulumi.APIRoute, method string) (LocalHandler, error) {
+	name, dirPath, err := b.handlerDirectory(route, method)
+	if err != nil {
+		return nil, err
+	}
+
+	goFilePath := path.Join(route.PathToFiles, method, fmt.Sprintf("%s.go", method))
+	binaryPath, err := filepath.Abs(path.Join(dirPath, name))
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, err
+	}
+
+	_, err = utils.RunCommand("go", []string{"build", "-o", binaryPath, goFilePath})
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, utils.NewErrorMessage("Error building Go handler", err)
+	}
+
+	handler, err := startGoLocalHandler(binaryPath, b.environment(route, method))
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, err
+	}
+
+	return newDrainingLocalHandler(handler, dirPath), nil
+}
+
+// buildTypeScriptHandler compiles a TypeScript controller and runs the method
+// through a Node shim.
+func (b *LocalHandlerBuilder) buildTypeScriptHandler(route auto_pulumi.APIRoute, method string) (LocalHandler, error) {
+	// Build the controller once for all its methods.
+	if !b.builtControllers[route.PathToFiles] {
+		_, err := utils.RunCommand("yarn", []string{"--cwd", route.PathToFiles, "install"})
+		if err != nil {
+			return nil, utils.NewErrorMessage("Error installing TypeScript dependencies", err)
+		}
+
+		_, err = utils.RunCommand("yarn", []string{"--cwd", route.PathToFiles, "build"})
+		if err != nil {
+			return nil, utils.NewErrorMessage("Error building TypeScript controller", err)
+		}
+
+		b.builtControllers[route.PathToFiles] = true
+	}
+
+	// Write out the shim if it hasn't been written yet.
+	shimPath := path.Join(b.BuildDirectory, TypeScriptLocalHandlerFileName)
+	shimExists, err := utils.DoesFileExist(shimPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !shimExists {
+		err = writeOutTemplateFile(b.BuildDirectory, TypeScriptLocalHandlerTemplateName, TypeScriptLocalHandlerFileName, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating Node shim: %v", err)
+		}
+	}
+
+	handlerFilePath, err := filepath.Abs(path.Join(route.PathToFiles, "bin", method, fmt.Sprintf("%s.js", method)))
+	if err != nil {
+		return nil, err
+	}
+
+	return newDrainingLocalHandler(&processLocalHandler{
+		command:       "node",
+		args:          []string{shimPath, handlerFilePath, fmt.Sprintf("%sHandler", strings.ToLower(method))},
+		environment:   b.environment(route, method),
+		resultDirPath: b.BuildDirectory,
+	}, ""), nil
+}
+
+type DotNetLocalRunnerFileArgs struct {
+	FunctionName string
+}
+
+// buildDotNetHandler publishes a dotnet controller method with a runner that can be
+// started with dotnet exec.
+func (b *LocalHandlerBuilder) buildDotNetHandler(route auto_pulumi.APIRoute, method string) (LocalHandler, error) {
+	_, dirPath, err := b.handlerDirectory(route, method)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy in the controller and add the runner to it.
+	dotNetControllerDirectory := path.Join(route.PathToFiles, method)
+	err = utils.CopyDirectory(dotNetControllerDirectory, dirPath, []string{"bin", "obj"})
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, utils.NewErrorMessage("Error copying dotnet controller files", err)
+	}
+
+	runnerTemplatePath := path.Join(FileTemplatePath, DotNetFileTemplateDirectoryName, DotNetLocalRunnerTemplateName)
+	runnerFilePath := path.Join(dirPath, DotNetLocalRunnerFileName)
+	err = utils.WriteOutTemplateToFile(runnerTemplatePath, runnerFilePath, DotNetLocalRunnerFileArgs{
+		FunctionName: utils.DashCaseToSentenceCase(method),
+	})
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, fmt.Errorf("Error creating dotnet runner: %v", err)
+	}
+
+	// Publish the controller as an executable.
+	publishPath := path.Join(dirPath, "publish")
+	_, err = utils.RunCommand("dotnet", []string{"publish", dirPath, "-p:OutputType=Exe", "-o", publishPath})
+	if err != nil {
+		os.RemoveAll(dirPath)
+		return nil, utils.NewErrorMessage("Error building dotnet handler", err)
+	}
+
+	return newDrainingLocalHandler(&processLocalHandler{
+		command:       "dotnet",
+		args:          []string{"exec", path.Join(publishPath, "app.dll")},
+		environment:   b.environment(route, method),
+		resultDirPath: b.BuildDirectory,
+	}, dirPath), nil
+}
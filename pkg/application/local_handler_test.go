@@ -0,0 +1,116 @@
+package application
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+)
+
+// blockingLocalHandler is a handler whose requests wait until they are released.
+type blockingLocalHandler struct {
+	body      string
+	started   chan bool
+	release   chan bool
+	isStopped bool
+}
+
+func newBlockingLocalHandler(body string) *blockingLocalHandler {
+	return &blockingLocalHandler{body: body, started: make(chan bool, 10), release: make(chan bool)}
+}
+
+func (h *blockingLocalHandler) Invoke(request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	h.started <- true
+	<-h.release
+	return events.APIGatewayProxyResponse{StatusCode: http.StatusOK, Body: h.body}, nil
+}
+
+func (h *blockingLocalHandler) Stop() {
+	h.isStopped = true
+}
+
+func TestDrainingLocalHandlerStop(t *testing.T) {
+	directory, err := ioutil.TempDir("", "stevie-handler")
+	if err != nil {
+		t.Fatalf("Error creating build directory: %v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	handler := newBlockingLocalHandler("old")
+	draining := newDrainingLocalHandler(handler, directory)
+
+	responses := make(chan events.APIGatewayProxyResponse)
+	go func() {
+		response, _ := draining.Invoke(events.APIGatewayProxyRequest{})
+		responses <- response
+	}()
+	<-handler.started
+
+	stopped := make(chan bool)
+	go func() {
+		draining.Stop()
+		stopped <- true
+	}()
+
+	// The running request keeps its handler and build directory.
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned before the running request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, err := os.Stat(directory); err != nil {
+		t.Fatalf("Build directory was removed while a request was running: %v", err)
+	}
+
+	close(handler.release)
+	if response := <-responses; response.Body != "old" {
+		t.Errorf("Running request returned %q", response.Body)
+	}
+	<-stopped
+
+	if !handler.isStopped {
+		t.Error("Handler was not stopped")
+	}
+	if _, err := os.Stat(directory); !os.IsNotExist(err) {
+		t.Errorf("Build directory was not removed: %v", err)
+	}
+	if _, err := draining.Invoke(events.APIGatewayProxyRequest{}); err != errLocalHandlerStopped {
+		t.Errorf("Invoking a stopped handler returned %v", err)
+	}
+}
+
+func TestLocalServerSwapsHandlersWithoutDroppingRequests(t *testing.T) {
+	route := auto_pulumi.APIRoute{Name: "users", Route: "/users"}
+	oldHandler := newBlockingLocalHandler("old")
+	server := &LocalServer{Routes: []LocalRoute{{Route: route, Method: "get", Handler: newDrainingLocalHandler(oldHandler, "")}}}
+
+	recorder := httptest.NewRecorder()
+	served := make(chan bool)
+	go func() {
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/users", nil))
+		served <- true
+	}()
+	<-oldHandler.started
+
+	newHandler := newBlockingLocalHandler("new")
+	close(newHandler.release)
+	server.setRouteHandler(LocalRoute{Route: route, Method: "get", Handler: newDrainingLocalHandler(newHandler, "")})
+
+	// New requests go to the new handler while the old one finishes.
+	newRecorder := httptest.NewRecorder()
+	server.ServeHTTP(newRecorder, httptest.NewRequest(http.MethodGet, "/users", nil))
+	if got := newRecorder.Body.String(); got != "new" {
+		t.Errorf("Request after the swap returned %q", got)
+	}
+
+	close(oldHandler.release)
+	<-served
+	if got := recorder.Body.String(); recorder.Code != http.StatusOK || got != "old" {
+		t.Errorf("Request running during the swap returned %d %q", recorder.Code, got)
+	}
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// statusResponseWriter keeps track of the status code of a response.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// localFallbackProxy forwards requests for routes that aren't served locally to a
+// deployed API.
+type localFallbackProxy struct {
+	TargetURL *url.URL
+	proxy     *httputil.ReverseProxy
+}
+
+// newLocalFallbackProxy creates a proxy to the stage URL of a deployed API.
+func newLocalFallbackProxy(targetURL string) (*localFallbackProxy, error) {
+	target, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing fallback URL %s: %v", targetURL, err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+
+		// API Gateway routes on the Host header so it has to match the target.
+		r.Host = target.Host
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		utils.Printf("Error forwarding %s %s to %s: %v\n", r.Method, r.URL.Path, target.Host, err)
+		writeAPIGatewayError(w, http.StatusBadGateway, "Bad Gateway")
+	}
+
+	return &localFallbackProxy{TargetURL: target, proxy: proxy}, nil
+}
+
+// ServeHTTP forwards a request to the deployed API.
+func (p *localFallbackProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	statusWriter := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+	p.proxy.ServeHTTP(statusWriter, r)
+
+	duration := float64(time.Since(start).Microseconds()) / 1000
+	utils.Printf("%s %s %d %.3f ms -> %s\n", r.Method, r.URL.Path, statusWriter.statusCode, duration, p.TargetURL.String())
+}
+
+// hasRoutePath checks if a local route is served at a request path.
+func (s *LocalServer) hasRoutePath(r *http.Request) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	_, ok := s.findRoutePath(r.URL.Path)
+	return ok
+}
+
+// filterRoutes keeps only the routes with the given names. All the routes are kept
+// if no names are given.
+func filterRoutes(routes []auto_pulumi.APIRoute, names []string) ([]auto_pulumi.APIRoute, error) {
+	if len(names) == 0 {
+		return routes, nil
+	}
+
+	routesByName := make(map[string]auto_pulumi.APIRoute)
+	for _, route := range routes {
+		routesByName[route.Name] = route
+	}
+
+	var filteredRoutes []auto_pulumi.APIRoute
+	for _, name := range names {
+		route, ok := routesByName[name]
+		if !ok {
+			return nil, fmt.Errorf("Route %s does not exist", name)
+		}
+		filteredRoutes = append(filteredRoutes, route)
+	}
+
+	return filteredRoutes, nil
+}
@@ -0,0 +1,349 @@
+package application
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// ReplayHeader marks replayed requests so a recording server doesn't record them again.
+const ReplayHeader = "X-Stevie-Replay"
+
+// replayIgnoredHeaders are the headers that change between requests or are set by
+// the transport, so they are left out of replays and diffs.
+var replayIgnoredHeaders = map[string]bool{
+	"Accept-Encoding":   true,
+	"Connection":        true,
+	"Content-Length":    true,
+	"Date":              true,
+	"Host":              true,
+	"Transfer-Encoding": true,
+	"Via":               true,
+	"X-Amz-Cf-Id":       true,
+	"X-Amz-Cf-Pop":      true,
+	"X-Amzn-Requestid":  true,
+	"X-Amzn-Trace-Id":   true,
+	"X-Amz-Apigw-Id":    true,
+	"X-Cache":           true,
+}
+
+// LocalFixtureRequest is a recorded request.
+type LocalFixtureRequest struct {
+	Method          string      `json:"method"`
+	Path            string      `json:"path"`
+	Query           string      `json:"query,omitempty"`
+	Headers         http.Header `json:"headers,omitempty"`
+	Body            string      `json:"body,omitempty"`
+	IsBase64Encoded bool        `json:"isBase64Encoded,omitempty"`
+}
+
+// LocalFixtureResponse is a recorded response.
+type LocalFixtureResponse struct {
+	StatusCode      int         `json:"statusCode"`
+	Headers         http.Header `json:"headers,omitempty"`
+	Body            string      `json:"body,omitempty"`
+	IsBase64Encoded bool        `json:"isBase64Encoded,omitempty"`
+}
+
+// LocalFixture is a request to a route and the response the local server gave.
+type LocalFixture struct {
+	Route    string               `json:"route"`
+	Method   string               `json:"method"`
+	Request  LocalFixtureRequest  `json:"request"`
+	Response LocalFixtureResponse `json:"response"`
+}
+
+// recordingResponseWriter keeps a copy of a response as it is written.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *recordingResponseWriter) Write(body []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.body.Write(body)
+	return w.ResponseWriter.Write(body)
+}
+
+// localRecorder wraps the local server and saves each request to a route as a
+// fixture under Directory/<route>/<method>.
+type localRecorder struct {
+	Server    *LocalServer
+	Directory string
+
+	mutex        sync.Mutex
+	fixtureCount map[string]int
+}
+
+// newLocalRecorder creates a recorder for a server.
+func newLocalRecorder(server *LocalServer, directory string) *localRecorder {
+	return &localRecorder{
+		Server:       server,
+		Directory:    directory,
+		fixtureCount: make(map[string]int),
+	}
+}
+
+// ServeHTTP serves a request with the local server and records it.
+func (l *localRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeAPIGatewayError(w, http.StatusBadRequest, "Bad Request")
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Replayed requests are already recorded.
+	if r.Header.Get(ReplayHeader) != "" {
+		l.Server.ServeHTTP(w, r)
+		return
+	}
+
+	recordingWriter := &recordingResponseWriter{ResponseWriter: w}
+	l.Server.ServeHTTP(recordingWriter, r)
+
+	// Only requests to a route are recorded since fixtures are keyed by route.
+	route, ok := l.Server.findPreflightRoute(r)
+	method := strings.ToLower(http.MethodOptions)
+	if !ok {
+		route, ok = l.Server.findRoute(r)
+		method = route.Method
+	}
+	if !ok {
+		return
+	}
+
+	if recordingWriter.statusCode == 0 {
+		recordingWriter.statusCode = http.StatusOK
+	}
+
+	requestBody, requestIsBase64Encoded := encodeRequestBody(body)
+	responseBody, responseIsBase64Encoded := encodeRequestBody(recordingWriter.body.Bytes())
+	fixture := LocalFixture{
+		Route:  route.Route.Name,
+		Method: method,
+		Request: LocalFixtureRequest{
+			Method:          r.Method,
+			Path:            r.URL.Path,
+			Query:           r.URL.RawQuery,
+			Headers:         r.Header,
+			Body:            requestBody,
+			IsBase64Encoded: requestIsBase64Encoded,
+		},
+		Response: LocalFixtureResponse{
+			StatusCode:      recordingWriter.statusCode,
+			Headers:         recordingWriter.Header(),
+			Body:            responseBody,
+			IsBase64Encoded: responseIsBase64Encoded,
+		},
+	}
+
+	err = l.saveFixture(fixture)
+	if err != nil {
+		utils.Printf("Error recording request: %v\n", err)
+	}
+}
+
+// saveFixture writes a fixture to the next free file for its route and method.
+func (l *localRecorder) saveFixture(fixture LocalFixture) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	fixtureDirectory := path.Join(l.Directory, fixture.Route, fixture.Method)
+	err := os.MkdirAll(fixtureDirectory, 0755)
+	if err != nil {
+		return err
+	}
+
+	// Continue numbering after any fixtures already in the directory.
+	if _, ok := l.fixtureCount[fixtureDirectory]; !ok {
+		existingFixtures, err := filepath.Glob(path.Join(fixtureDirectory, "*.json"))
+		if err != nil {
+			return err
+		}
+		l.fixtureCount[fixtureDirectory] = len(existingFixtures)
+	}
+
+	var fixturePath string
+	for {
+		l.fixtureCount[fixtureDirectory]++
+		fixturePath = path.Join(fixtureDirectory, fmt.Sprintf("%04d.json", l.fixtureCount[fixtureDirectory]))
+		exists, err := utils.DoesFileExist(fixturePath)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			break
+		}
+	}
+
+	contents, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fixturePath, append(contents, '\n'), 0644)
+}
+
+// LocalFixtureFile is a fixture and the file it was read from.
+type LocalFixtureFile struct {
+	Path    string
+	Fixture LocalFixture
+}
+
+// ReadLocalFixtures reads all the fixtures in a directory in order.
+func ReadLocalFixtures(directory string) ([]LocalFixtureFile, error) {
+	var fixtureFiles []LocalFixtureFile
+	err := filepath.Walk(directory, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || filepath.Ext(filePath) != ".json" {
+			return nil
+		}
+
+		contents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		var fixture LocalFixture
+		err = json.Unmarshal(contents, &fixture)
+		if err != nil {
+			return fmt.Errorf("Error reading fixture %s: %v", filePath, err)
+		}
+
+		fixtureFiles = append(fixtureFiles, LocalFixtureFile{Path: filePath, Fixture: fixture})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fixtureFiles, nil
+}
+
+// decodeFixtureBody decodes a recorded body.
+func decodeFixtureBody(body string, isBase64Encoded bool) ([]byte, error) {
+	if isBase64Encoded {
+		return base64.StdEncoding.DecodeString(body)
+	}
+
+	return []byte(body), nil
+}
+
+// ReplayLocalFixture sends a recorded request to a target URL and returns the response.
+func ReplayLocalFixture(targetURL string, fixture LocalFixture) (LocalFixtureResponse, error) {
+	body, err := decodeFixtureBody(fixture.Request.Body, fixture.Request.IsBase64Encoded)
+	if err != nil {
+		return LocalFixtureResponse{}, fmt.Errorf("Error decoding request body: %v", err)
+	}
+
+	requestURL := strings.TrimSuffix(targetURL, "/") + fixture.Request.Path
+	if fixture.Request.Query != "" {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, fixture.Request.Query)
+	}
+
+	request, err := http.NewRequest(fixture.Request.Method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return LocalFixtureResponse{}, err
+	}
+
+	for key, values := range fixture.Request.Headers {
+		if replayIgnoredHeaders[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
+	request.Header.Set(ReplayHeader, "true")
+
+	client := &http.Client{Timeout: LocalHandlerTimeout + 5*time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return LocalFixtureResponse{}, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return LocalFixtureResponse{}, fmt.Errorf("Error reading response body: %v", err)
+	}
+
+	encodedBody, isBase64Encoded := encodeRequestBody(responseBody)
+	return LocalFixtureResponse{
+		StatusCode:      response.StatusCode,
+		Headers:         response.Header,
+		Body:            encodedBody,
+		IsBase64Encoded: isBase64Encoded,
+	}, nil
+}
+
+// formatFixtureResponse formats a response for diffing. Only the headers in the
+// expected response are included so headers added by the gateway are not reported.
+func formatFixtureResponse(response LocalFixtureResponse, expectedHeaders http.Header) string {
+	lines := []string{fmt.Sprintf("Status: %d", response.StatusCode)}
+
+	var keys []string
+	for key := range expectedHeaders {
+		if !replayIgnoredHeaders[http.CanonicalHeaderKey(key)] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range response.Headers[http.CanonicalHeaderKey(key)] {
+			lines = append(lines, fmt.Sprintf("%s: %s", key, value))
+		}
+	}
+
+	lines = append(lines, "")
+	body, err := decodeFixtureBody(response.Body, response.IsBase64Encoded)
+	if err != nil {
+		body = []byte(response.Body)
+	}
+
+	var prettyBody bytes.Buffer
+	if json.Indent(&prettyBody, body, "", "  ") == nil {
+		lines = append(lines, prettyBody.String())
+	} else if response.IsBase64Encoded {
+		lines = append(lines, response.Body)
+	} else {
+		lines = append(lines, string(body))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// DiffLocalFixtureResponse diffs a recorded response against a new one. It returns
+// an empty string if they match.
+func DiffLocalFixtureResponse(expected, actual LocalFixtureResponse) string {
+	return utils.DiffLines(
+		formatFixtureResponse(expected, expected.Headers),
+		formatFixtureResponse(actual, expected.Headers),
+	)
+}
@@ -0,0 +1,177 @@
+package application
+
+import (
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+// localWatchExclusionList are the folders the reloader ignores. They hold build
+// output and dependencies, not source.
+var localWatchExclusionList = []string{"node_modules", "bin", "obj"}
+
+// localServerReloader rebuilds the routes of a local server as the controllers
+// and the config change.
+type localServerReloader struct {
+	Server          *LocalServer
+	ConfigFilePaths []string
+	LoadRoutes      func() ([]auto_pulumi.APIRoute, error)
+	Routes          []auto_pulumi.APIRoute
+}
+
+// Watch watches the controllers and config for changes. It blocks forever.
+func (r *localServerReloader) Watch() {
+	watcher := &utils.FileWatcher{
+		Paths:         append([]string{path.Join(ApplicationFolder, ControllersFolder)}, r.ConfigFilePaths...),
+		ExclusionList: localWatchExclusionList,
+	}
+	watcher.Watch(r.handleChanges)
+}
+
+// findRouteForPath finds the route whose controller contains a file. It returns
+// the path of the file relative to the controller.
+func (r *localServerReloader) findRouteForPath(filePath string) (auto_pulumi.APIRoute, string, bool) {
+	var matchedRoute auto_pulumi.APIRoute
+	var matchedPath string
+	found := false
+
+	// Use the deepest controller containing the file.
+	for _, route := range r.Routes {
+		controllerPath := path.Clean(route.PathToFiles)
+		if !strings.HasPrefix(filePath, controllerPath+"/") {
+			continue
+		}
+
+		if !found || len(controllerPath) > len(path.Clean(matchedRoute.PathToFiles)) {
+			matchedRoute = route
+			matchedPath = strings.TrimPrefix(filePath, controllerPath+"/")
+			found = true
+		}
+	}
+
+	return matchedRoute, matchedPath, found
+}
+
+// isConfigFile checks if a changed file is one of the config files.
+func (r *localServerReloader) isConfigFile(filePath string) bool {
+	for _, configFilePath := range r.ConfigFilePaths {
+		if filePath == path.Clean(configFilePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleChanges rebuilds whatever is affected by a set of changed files.
+func (r *localServerReloader) handleChanges(changedPaths []string) {
+	configChanged := false
+	changedControllers := make(map[string]bool)
+	changedMethods := make(map[string]map[string]bool)
+
+	for _, changedPath := range changedPaths {
+		changedPath = path.Clean(changedPath)
+		if r.isConfigFile(changedPath) {
+			configChanged = true
+			continue
+		}
+
+		route, relativePath, ok := r.findRouteForPath(changedPath)
+		if !ok {
+			continue
+		}
+
+		// Changes inside a method folder only affect that method. Anything else in
+		// the controller, like the package.json, affects every method.
+		pathParts := strings.Split(relativePath, "/")
+		if auto_pulumi.IsControllerMethod(pathParts[0]) {
+			if changedMethods[route.Name] == nil {
+				changedMethods[route.Name] = make(map[string]bool)
+			}
+			changedMethods[route.Name][strings.ToLower(pathParts[0])] = true
+		} else {
+			changedControllers[route.Name] = true
+		}
+	}
+
+	if configChanged {
+		r.reloadConfig()
+	}
+
+	for _, route := range r.Routes {
+		if changedControllers[route.Name] || len(changedMethods[route.Name]) > 0 {
+			r.syncRoute(route, changedMethods[route.Name], changedControllers[route.Name])
+		}
+	}
+}
+
+// reloadConfig reads the routes from the config and adds, removes, or rebuilds
+// the routes that changed.
+func (r *localServerReloader) reloadConfig() {
+	utils.Print("Config changed, reloading routes.")
+	newRoutes, err := r.LoadRoutes()
+	if err != nil {
+		utils.Printf("Error reloading config, keeping the current routes: %v\n", err)
+		return
+	}
+
+	oldRoutesByName := make(map[string]auto_pulumi.APIRoute)
+	for _, route := range r.Routes {
+		oldRoutesByName[route.Name] = route
+	}
+
+	newRoutesByName := make(map[string]auto_pulumi.APIRoute)
+	for _, route := range newRoutes {
+		newRoutesByName[route.Name] = route
+	}
+
+	// Remove the routes that were deleted or changed.
+	r.Server.removeRouteHandlers(func(localRoute LocalRoute) bool {
+		newRoute, ok := newRoutesByName[localRoute.Route.Name]
+		return !ok || !reflect.DeepEqual(newRoute, localRoute.Route)
+	})
+
+	// Build the routes that were added or changed.
+	r.Routes = newRoutes
+	for _, route := range newRoutes {
+		oldRoute, ok := oldRoutesByName[route.Name]
+		if !ok || !reflect.DeepEqual(oldRoute, route) {
+			r.syncRoute(route, nil, true)
+		}
+	}
+}
+
+// syncRoute makes the methods served for a route match its controller folder,
+// building new methods and rebuilding changed ones.
+func (r *localServerReloader) syncRoute(route auto_pulumi.APIRoute, changedMethods map[string]bool, rebuildAll bool) {
+	r.Server.builder.Invalidate(route.PathToFiles)
+
+	// A controller without any methods just stops being served.
+	methods, err := auto_pulumi.ReadRoutesFromControllerDirectory(route.PathToFiles)
+	if err != nil {
+		methods = nil
+	}
+
+	currentMethods := make(map[string]bool)
+	for _, method := range methods {
+		currentMethods[method] = true
+	}
+
+	servedMethods := r.Server.servedMethods(route.Name)
+	r.Server.removeRouteHandlers(func(localRoute LocalRoute) bool {
+		return localRoute.Route.Name == route.Name && !currentMethods[localRoute.Method]
+	})
+
+	// If a build fails the old handler keeps serving the route.
+	for _, method := range methods {
+		if rebuildAll || changedMethods[method] || !servedMethods[method] {
+			err = r.Server.buildRouteMethod(route, method)
+			if err != nil {
+				utils.Print(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+)
+
+func TestMatchRoutePath(t *testing.T) {
+	tests := []struct {
+		route          string
+		requestPath    string
+		pathParameters map[string]string
+	}{
+		{route: "/users", requestPath: "/users", pathParameters: map[string]string{}},
+		{route: "/users", requestPath: "/users/", pathParameters: map[string]string{}},
+		{route: "/users", requestPath: "/posts", pathParameters: nil},
+		{route: "/users", requestPath: "/users/1", pathParameters: nil},
+		{route: "/users/{id}", requestPath: "/users/1", pathParameters: map[string]string{"id": "1"}},
+		{route: "/users/{id}", requestPath: "/users", pathParameters: nil},
+		{route: "/users/{id}/posts/{postId}", requestPath: "/users/1/posts/2", pathParameters: map[string]string{"id": "1", "postId": "2"}},
+		{route: "/files/{path+}", requestPath: "/files/a/b/c.txt", pathParameters: map[string]string{"path": "a/b/c.txt"}},
+		{route: "/files/{path+}", requestPath: "/files", pathParameters: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.route+" "+test.requestPath, func(t *testing.T) {
+			pathParameters, ok := matchRoutePath(test.route, test.requestPath)
+			if ok != (test.pathParameters != nil) {
+				t.Fatalf("Expected the match to be %v", !ok)
+			}
+			if ok && !reflect.DeepEqual(pathParameters, test.pathParameters) {
+				t.Errorf("Got the path parameters %v, want %v", pathParameters, test.pathParameters)
+			}
+		})
+	}
+}
+
+func TestFindRoutePath(t *testing.T) {
+	tests := []struct {
+		name        string
+		routes      []string
+		requestPath string
+		want        string
+	}{
+		{name: "plain over parameter", routes: []string{"/users/{id}", "/users/me"}, requestPath: "/users/me", want: "/users/me"},
+		{name: "parameter over greedy", routes: []string{"/users/{path+}", "/users/{id}"}, requestPath: "/users/1", want: "/users/{id}"},
+		{name: "greedy for deeper paths", routes: []string{"/users/{path+}", "/users/{id}"}, requestPath: "/users/1/posts", want: "/users/{path+}"},
+		{name: "first differing segment decides", routes: []string{"/{a}/x/y", "/b/{c}/{d}"}, requestPath: "/b/x/y", want: "/b/{c}/{d}"},
+		{name: "first differing segment decides in any order", routes: []string{"/b/{c}/{d}", "/{a}/x/y"}, requestPath: "/b/x/y", want: "/b/{c}/{d}"},
+		{name: "later plain segments", routes: []string{"/{a}/{b}/y", "/{a}/x/{c}"}, requestPath: "/b/x/y", want: "/{a}/x/{c}"},
+		{name: "no match", routes: []string{"/users"}, requestPath: "/posts", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &LocalServer{}
+			for _, route := range test.routes {
+				server.Routes = append(server.Routes, LocalRoute{Route: auto_pulumi.APIRoute{Route: route}, Method: "get"})
+			}
+
+			got, ok := server.findRoutePath(test.requestPath)
+			if ok != (test.want != "") {
+				t.Fatalf("Expected finding a route to be %v", !ok)
+			}
+			if got != test.want {
+				t.Errorf("Found %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package application
 import (
 	"fmt"
 	"path"
+	"strings"
 
 	"github.com/zchase/stevie/pkg/utils"
 )
@@ -26,6 +27,8 @@ var (
 	TypeScriptPackageJSONFileName        = "package.json"
 	TypeScriptPackageJSONTemplateName    = "package_json.tmpl"
 	TypesScriptUtilsDirectory            = "stevie-utils"
+	TypeScriptLocalHandlerFileName       = "local-handler.js"
+	TypeScriptLocalHandlerTemplateName   = "local_handler_js.tmpl"
 
 	// Go
 	GoFileTemplatesDirectoryName = "go"
@@ -34,8 +37,33 @@ var (
 
 	// Dotnet
 	DotNetFileTemplateDirectoryName = "dotnet"
+	DotNetLocalRunnerFileName       = "LocalRunner.cs"
+	DotNetLocalRunnerTemplateName   = "local_runner.tmpl"
+
+	// Local server
+	LocalServerDirectory = "tmp-local"
+
+	// Invoke
+	InvokeDirectory = "tmp-invoke"
+
+	// Local data for the DynamoDB and S3 stand-ins
+	LocalDataDirectory = "local-data"
+
+	// Git
+	GitIgnoreFileName = ".gitignore"
 )
 
+// gitIgnoreEntries are the files a new project keeps out of git. The env files
+// hold the secrets for each environment.
+var gitIgnoreEntries = []string{
+	fmt.Sprintf("%s.*", LocalEnvFilePrefix),
+	"node_modules/",
+	LocalServerDirectory + "/",
+	InvokeDirectory + "/",
+	LocalDataDirectory + "/",
+	ApplicationConfigPath + "/.*.lock",
+}
+
 // PackageJsonArgs define the args need to generate a package.json file.
 type PackageJsonArgs struct {
 	Name        string
@@ -56,6 +84,12 @@ func CreateProjectStructure(name, description string) error {
 		return err
 	}
 
+	// Keep secrets and build output out of git.
+	err = utils.WriteNewFile(".", GitIgnoreFileName, strings.Join(gitIgnoreEntries, "\n")+"\n")
+	if err != nil {
+		return fmt.Errorf("Error creating %s: %v", GitIgnoreFileName, err)
+	}
+
 	return nil
 }
 
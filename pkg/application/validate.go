@@ -0,0 +1,511 @@
+package application
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigProblem is a problem found in a config file.
+type ConfigProblem struct {
+	File    string
+	Line    int
+	Message string
+}
+
+// String formats the problem as file:line: message.
+func (p ConfigProblem) String() string {
+	if p.Line == 0 {
+		return fmt.Sprintf("%s: %s", p.File, p.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+}
+
+// baseConfigKeys are the keys the base config can have.
+var baseConfigKeys = []string{"version", "name", "dashcasename", "description", "aws", "defaults", "functions", "variables", "routes"}
+
+// awsConfigKeys are the keys of the AWS settings.
+var awsConfigKeys = []string{"region", "pluginversion", "profile", "assumerole", "defaulttags"}
+
+// assumeRoleConfigKeys are the keys of the role the AWS provider assumes.
+var assumeRoleConfigKeys = []string{"rolearn", "sessionname", "externalid"}
+
+// defaultsConfigKeys are the keys of the defaults for new controllers.
+var defaultsConfigKeys = []string{"language", "corsenabled"}
+
+// routeConfigKeys are the keys a route in the base config can have.
+var routeConfigKeys = []string{"name", "route", "pathtofiles", "corsenabled", "enabled", "functions", "variables", "methods"}
+
+// methodConfigKeys are the keys of the settings of a method of a route.
+var methodConfigKeys = []string{"functions", "variables"}
+
+// functionConfigKeys are the keys of the functions settings.
+var functionConfigKeys = []string{"memory", "timeout"}
+
+// unsupportedFunctionConfigKeys are the functions settings Lambda has but Stevie
+// can't deploy yet, with how they are described in problems.
+var unsupportedFunctionConfigKeys = map[string]string{
+	"architecture":     "architecture",
+	"ephemeralstorage": "ephemeral storage",
+}
+
+// yamlErrorLine finds the line number in a YAML syntax error.
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): `)
+
+// configValidator collects the problems found in a config file.
+type configValidator struct {
+	file     string
+	problems []ConfigProblem
+
+	// fileOf finds the file a node was read from when the config was merged from
+	// more than one file.
+	fileOf func(node *yaml.Node) string
+}
+
+func (v *configValidator) addProblem(node *yaml.Node, message string, args ...interface{}) {
+	line := 0
+	file := v.file
+	if node != nil {
+		line = node.Line
+		if v.fileOf != nil {
+			file = v.fileOf(node)
+		}
+	}
+
+	v.problems = append(v.problems, ConfigProblem{
+		File:    file,
+		Line:    line,
+		Message: fmt.Sprintf(message, args...),
+	})
+}
+
+// mappingValues returns the key and value nodes of a mapping by key. Unknown and
+// repeated keys are reported. Keys are compared exactly like they are when the
+// config is decoded, so a key in the wrong case is unknown.
+func (v *configValidator) mappingValues(node *yaml.Node, knownKeys []string, description string) map[string][2]*yaml.Node {
+	values := make(map[string][2]*yaml.Node)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+
+		if _, ok := values[name]; ok {
+			v.addProblem(key, "%s has the key %q more than once", description, key.Value)
+			continue
+		}
+
+		known := false
+		suggestion := ""
+		for _, knownKey := range knownKeys {
+			if knownKey == name {
+				known = true
+			} else if strings.EqualFold(knownKey, name) {
+				suggestion = knownKey
+			}
+		}
+		if !known {
+			if suggestion != "" {
+				v.addProblem(key, "%s has an unknown key %q, did you mean %s", description, key.Value, suggestion)
+			} else {
+				v.addProblem(key, "%s has an unknown key %q", description, key.Value)
+			}
+			continue
+		}
+
+		values[name] = [2]*yaml.Node{key, value}
+	}
+
+	return values
+}
+
+// requiredString checks that a key is set to a string and returns it.
+func (v *configValidator) requiredString(parent *yaml.Node, values map[string][2]*yaml.Node, key, description string) (string, *yaml.Node) {
+	pair, ok := values[key]
+	if !ok {
+		v.addProblem(parent, "%s is missing %s", description, key)
+		return "", nil
+	}
+
+	value := pair[1]
+	if value.Kind != yaml.ScalarNode || value.Tag == "!!null" || value.Value == "" {
+		v.addProblem(value, "%s must be a non-empty string", key)
+		return "", nil
+	}
+
+	return value.Value, value
+}
+
+// routeKey normalises a route so routes that API Gateway would treat as the same
+// compare equal. Path parameters match any name.
+func routeKey(route string) string {
+	parts := strings.Split(strings.Trim(route, "/"), "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			parts[i] = "{}"
+		}
+	}
+
+	return "/" + strings.Join(parts, "/")
+}
+
+// validateRoute checks a single route.
+func (v *configValidator) validateRoute(node *yaml.Node, index int, names, routes map[string]*yaml.Node, previousRoutes *[]*yaml.Node) {
+	description := fmt.Sprintf("route %d", index+1)
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "%s must be a mapping", description)
+		return
+	}
+
+	values := v.mappingValues(node, routeConfigKeys, description)
+
+	name, nameNode := v.requiredString(node, values, "name", description)
+	if name != "" {
+		description = fmt.Sprintf("route %s", name)
+		if first, ok := names[name]; ok {
+			v.addProblem(nameNode, "route name %q is already used on line %d", name, first.Line)
+		} else {
+			names[name] = nameNode
+		}
+	}
+
+	route, routeNode := v.requiredString(node, values, "route", description)
+	if route != "" {
+		if err := auto_pulumi.ValidateRoutePath(route); err != nil {
+			v.addProblem(routeNode, "%v", err)
+		}
+
+		key := routeKey(route)
+		if first, ok := routes[key]; ok {
+			v.addProblem(routeNode, "route %q collides with route %q on line %d", route, first.Value, first.Line)
+		} else {
+			routes[key] = routeNode
+		}
+
+		v.validateRouteParameters(routeNode, previousRoutes)
+	}
+
+	pathToFiles, pathNode := v.requiredString(node, values, "pathtofiles", description)
+	if pathToFiles != "" {
+		info, err := os.Stat(pathToFiles)
+		if err != nil || !info.IsDir() {
+			v.addProblem(pathNode, "pathtofiles %q is not a directory", pathToFiles)
+		} else if _, err = auto_pulumi.ReadRoutesFromControllerDirectory(pathToFiles); err != nil {
+			v.addProblem(pathNode, "pathtofiles %q has no method folders (%s)", pathToFiles, strings.Join(auto_pulumi.ControllerMethods, ", "))
+		}
+	}
+
+	for _, key := range []string{"corsenabled", "enabled"} {
+		if pair, ok := values[key]; ok {
+			if pair[1].Kind != yaml.ScalarNode || pair[1].Tag != "!!bool" {
+				v.addProblem(pair[1], "%s must be true or false", key)
+			}
+		}
+	}
+
+	if pair, ok := values["functions"]; ok {
+		v.validateFunctions(pair[1], description)
+	}
+
+	if pair, ok := values["variables"]; ok {
+		v.validateVariables(pair[1])
+	}
+
+	if pair, ok := values["methods"]; ok {
+		v.validateMethods(pair[1], description)
+	}
+}
+
+// validateRouteParameters checks a route names its path parameters the same way as
+// the earlier routes it shares resources with.
+func (v *configValidator) validateRouteParameters(routeNode *yaml.Node, previousRoutes *[]*yaml.Node) {
+	for _, previousRoute := range *previousRoutes {
+		if err := auto_pulumi.CheckRouteParameters(routeNode.Value, previousRoute.Value); err != nil {
+			v.addProblem(routeNode, "%v on line %d", err, previousRoute.Line)
+			break
+		}
+	}
+
+	*previousRoutes = append(*previousRoutes, routeNode)
+}
+
+// validateMethods checks the settings of the methods of a route.
+func (v *configValidator) validateMethods(node *yaml.Node, description string) {
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "methods must be a mapping of methods to their settings")
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if !auto_pulumi.IsControllerMethod(key.Value) {
+			v.addProblem(key, "%q is not a method, use one of %s", key.Value, strings.Join(auto_pulumi.ControllerMethods, ", "))
+			continue
+		}
+
+		methodDescription := fmt.Sprintf("method %s of %s", key.Value, description)
+		if value.Kind != yaml.MappingNode {
+			v.addProblem(value, "%s must be a mapping", methodDescription)
+			continue
+		}
+
+		values := v.mappingValues(value, methodConfigKeys, methodDescription)
+		if pair, ok := values["functions"]; ok {
+			v.validateFunctions(pair[1], methodDescription)
+		}
+		if pair, ok := values["variables"]; ok {
+			v.validateVariables(pair[1])
+		}
+	}
+}
+
+// validateVariables checks the environment variables of the functions.
+func (v *configValidator) validateVariables(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "variables must be a mapping of names to values")
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if err := auto_pulumi.ValidateVariableName(key.Value); err != nil {
+			v.addProblem(key, "variable %v", err)
+		}
+		if value.Kind != yaml.ScalarNode {
+			v.addProblem(value, "variable %q must be a string", key.Value)
+		}
+	}
+}
+
+// validateAWS checks the AWS settings.
+func (v *configValidator) validateAWS(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "aws must be a mapping")
+		return
+	}
+
+	values := v.mappingValues(node, awsConfigKeys, "aws")
+	for _, key := range []string{"region", "pluginversion", "profile"} {
+		if _, ok := values[key]; ok {
+			v.requiredString(node, values, key, "aws")
+		}
+	}
+
+	if pair, ok := values["pluginversion"]; ok && !strings.HasPrefix(pair[1].Value, "v") {
+		v.addProblem(pair[1], "pluginversion %q must start with v, like %s", pair[1].Value, auto_pulumi.DefaultAWSPluginVersion)
+	}
+
+	if pair, ok := values["assumerole"]; ok {
+		if pair[1].Kind != yaml.MappingNode {
+			v.addProblem(pair[1], "assumerole must be a mapping")
+		} else {
+			roleValues := v.mappingValues(pair[1], assumeRoleConfigKeys, "assumerole")
+			v.requiredString(pair[1], roleValues, "rolearn", "assumerole")
+		}
+	}
+
+	if pair, ok := values["defaulttags"]; ok {
+		if pair[1].Kind != yaml.MappingNode {
+			v.addProblem(pair[1], "defaulttags must be a mapping of tag names to values")
+		} else {
+			for i := 1; i < len(pair[1].Content); i += 2 {
+				if pair[1].Content[i].Kind != yaml.ScalarNode {
+					v.addProblem(pair[1].Content[i], "tag %q must be a string", pair[1].Content[i-1].Value)
+				}
+			}
+		}
+	}
+}
+
+// validateDefaults checks the defaults for new controllers.
+func (v *configValidator) validateDefaults(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "defaults must be a mapping")
+		return
+	}
+
+	values := v.mappingValues(node, defaultsConfigKeys, "defaults")
+	if pair, ok := values["language"]; ok {
+		language := pair[1].Value
+		if language != TypeScriptControllerLanguage && language != GoControllerLanguage && language != DotNetControllerLanguage {
+			v.addProblem(pair[1], "language %q must be one of %s, %s, %s", language, TypeScriptControllerLanguage, GoControllerLanguage, DotNetControllerLanguage)
+		}
+	}
+	if pair, ok := values["corsenabled"]; ok {
+		if pair[1].Kind != yaml.ScalarNode || pair[1].Tag != "!!bool" {
+			v.addProblem(pair[1], "corsenabled must be true or false")
+		}
+	}
+}
+
+// validateFunctions checks the functions settings of the config, a route, or a method.
+func (v *configValidator) validateFunctions(node *yaml.Node, description string) {
+	if node.Kind != yaml.MappingNode {
+		v.addProblem(node, "functions must be a mapping")
+		return
+	}
+
+	// Report the settings that can't be deployed yet on their own so they aren't
+	// mistaken for typos.
+	supported := *node
+	supported.Content = nil
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if setting, ok := unsupportedFunctionConfigKeys[strings.ToLower(key.Value)]; ok {
+			v.addProblem(key, "functions of %s can't set the %s yet, the pulumi-aws version Stevie deploys with doesn't support it", description, setting)
+			continue
+		}
+		supported.Content = append(supported.Content, key, node.Content[i+1])
+	}
+
+	values := v.mappingValues(&supported, functionConfigKeys, fmt.Sprintf("functions of %s", description))
+	v.integerInRange(values, "memory", 128, 10240)
+	v.integerInRange(values, "timeout", 1, 900)
+}
+
+// integerInRange checks that a key, if set, is a whole number within a range.
+func (v *configValidator) integerInRange(values map[string][2]*yaml.Node, key string, min, max int) {
+	pair, ok := values[key]
+	if !ok {
+		return
+	}
+
+	var value int
+	if pair[1].Tag != "!!int" || pair[1].Decode(&value) != nil || value < min || value > max {
+		v.addProblem(pair[1], "%s must be a whole number from %d to %d", key, min, max)
+	}
+}
+
+// validate checks the parsed contents of a base config file.
+func (v *configValidator) validate(document *yaml.Node) {
+	if len(document.Content) == 0 {
+		v.addProblem(document, "config is empty")
+		return
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		v.addProblem(root, "config must be a mapping")
+		return
+	}
+
+	values := v.mappingValues(root, baseConfigKeys, "config")
+	v.requiredString(root, values, "name", "config")
+
+	if pair, ok := values["version"]; ok {
+		if version, err := strconv.Atoi(pair[1].Value); err != nil || version < 0 || pair[1].Tag != "!!int" {
+			v.addProblem(pair[1], "version must be a whole number")
+		}
+	}
+
+	if pair, ok := values["aws"]; ok {
+		v.validateAWS(pair[1])
+	}
+
+	if pair, ok := values["defaults"]; ok {
+		v.validateDefaults(pair[1])
+	}
+
+	if pair, ok := values["functions"]; ok {
+		v.validateFunctions(pair[1], "config")
+	}
+
+	if pair, ok := values["variables"]; ok {
+		v.validateVariables(pair[1])
+	}
+
+	pair, ok := values["routes"]
+	if !ok || pair[1].Tag == "!!null" {
+		return
+	}
+
+	routes := pair[1]
+	if routes.Kind != yaml.SequenceNode {
+		v.addProblem(routes, "routes must be a list")
+		return
+	}
+
+	routeNames := make(map[string]*yaml.Node)
+	routePaths := make(map[string]*yaml.Node)
+	var previousRoutes []*yaml.Node
+	for i, route := range routes.Content {
+		v.validateRoute(route, i, routeNames, routePaths, &previousRoutes)
+	}
+}
+
+// ValidateConfigFile checks a base config file and returns every problem found in
+// it. An error is only returned if the file couldn't be read.
+func ValidateConfigFile(filePath string) ([]ConfigProblem, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	validator := &configValidator{file: filePath}
+
+	var document yaml.Node
+	err = yaml.Unmarshal(contents, &document)
+	if err != nil {
+		line := 0
+		if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+			fmt.Sscanf(match[1], "%d", &line)
+		}
+		validator.problems = append(validator.problems, ConfigProblem{
+			File:    filePath,
+			Line:    line,
+			Message: strings.TrimPrefix(yamlErrorLine.ReplaceAllString(err.Error(), ""), "yaml: "),
+		})
+		return validator.problems, nil
+	}
+
+	validator.validate(&document)
+
+	sortConfigProblems(validator.problems)
+	return validator.problems, nil
+}
+
+// sortConfigProblems sorts problems by file and line.
+func sortConfigProblems(problems []ConfigProblem) {
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].File != problems[j].File {
+			return problems[i].File < problems[j].File
+		}
+		return problems[i].Line < problems[j].Line
+	})
+}
+
+// ValidateEnvironmentConfig checks the effective config of an environment, the base
+// config with the environment file merged on top of it. Problems are reported
+// against the file they came from. If env is empty only the base config is checked.
+func ValidateEnvironmentConfig(configPath, env string) ([]ConfigProblem, error) {
+	if env == "" {
+		return ValidateConfigFile(config.BaseFilePath(configPath))
+	}
+
+	merged, err := config.LoadMergedConfig(configPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := &configValidator{file: merged.BaseFilePath, fileOf: merged.FilePath}
+	validator.validate(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{merged.Root}})
+
+	sortConfigProblems(validator.problems)
+	return validator.problems, nil
+}
+
+// ValidateConfigNode checks a config that has already been parsed, like the effective
+// config of an environment.
+func ValidateConfigNode(description string, config *yaml.Node) []ConfigProblem {
+	validator := &configValidator{file: description}
+	validator.validate(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{config}})
+
+	return validator.problems
+}
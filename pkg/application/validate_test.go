@@ -0,0 +1,42 @@
+package application
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidateConfigNodeFunctions(t *testing.T) {
+	tests := []struct {
+		name      string
+		functions string
+		want      []string
+	}{
+		{name: "memory and timeout", functions: "memory: 512\ntimeout: 30", want: nil},
+		{name: "memory out of range", functions: "memory: 64", want: []string{"memory must be a whole number from 128 to 10240"}},
+		{name: "architecture", functions: "architecture: arm64", want: []string{"functions of config can't set the architecture yet, the pulumi-aws version Stevie deploys with doesn't support it"}},
+		{name: "ephemeral storage", functions: "memory: 512\nephemeralStorage: 1024", want: []string{"functions of config can't set the ephemeral storage yet, the pulumi-aws version Stevie deploys with doesn't support it"}},
+		{name: "unknown key", functions: "memroy: 512", want: []string{`functions of config has an unknown key "memroy"`}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents := "name: Demo App\ndashcasename: demo-app\nroutes: []\nfunctions:\n  " + strings.Replace(test.functions, "\n", "\n  ", -1) + "\n"
+
+			var document yaml.Node
+			err := yaml.Unmarshal([]byte(contents), &document)
+			if err != nil {
+				t.Fatalf("Error parsing config: %v", err)
+			}
+
+			var got []string
+			for _, problem := range ValidateConfigNode("config", document.Content[0]) {
+				got = append(got, problem.Message)
+			}
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Errorf("Got the problems\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+		})
+	}
+}
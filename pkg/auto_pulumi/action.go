@@ -167,6 +167,7 @@ func outputActionSteps(steps []auto.PreviewStep) {
 type PulumiAction struct {
 	CreateDeployment   func(environment string) (auto.Stack, error)
 	Environment        string
+	AWS                AWSSettings
 	Stack              auto.Stack
 	TemporaryDirectory *utils.TemporaryDirectory
 }
@@ -178,7 +179,7 @@ func (a *PulumiAction) Fail(err error, message string) error {
 }
 
 // SetUp sets up the action to run.
-func (a *PulumiAction) SetUp(ctx context.Context, configPath string) error {
+func (a *PulumiAction) SetUp(ctx context.Context) error {
 	// Create a spinner to show the user what is happening.
 	checkEnvSpinner := utils.CreateNewTerminalSpinner(
 		"Checking environment",
@@ -186,16 +187,9 @@ func (a *PulumiAction) SetUp(ctx context.Context, configPath string) error {
 		"Environment check failed.",
 	)
 
-	// Read config in.
-	projectConfig, err := utils.ReadConfigFile(configPath, a.Environment)
-	if err != nil {
-		checkEnvSpinner.Fail()
-		return err
-	}
-
 	// Create a tmp directory for compiliing the TypeScript lambdas.
 	tmp := &utils.TemporaryDirectory{Name: tmpDirName}
-	err = tmp.Create()
+	err := tmp.Create()
 	if err != nil {
 		return checkEnvSpinner.FailWithMessage("Error creating tmp directory", err)
 	}
@@ -210,7 +204,7 @@ func (a *PulumiAction) SetUp(ctx context.Context, configPath string) error {
 		"Pulumi Program failed to generate.",
 	)
 
-	stack, err := a.CreateDeployment(projectConfig.Environment)
+	stack, err := a.CreateDeployment(a.Environment)
 	if err != nil {
 		return createAPISpinner.FailWithMessage("Error creating API Deployment", err)
 	}
@@ -227,17 +221,12 @@ func (a *PulumiAction) SetUp(ctx context.Context, configPath string) error {
 		"Failed to set up program execution environment.",
 	)
 
-	// Install plugins and set the config values.
-	workspace := stack.Workspace()
-
-	err = workspace.InstallPlugin(ctx, "aws", "v3.2.1")
+	// Install the AWS plugin and set the provider config for the environment.
+	err = ApplyAWSSettings(ctx, stack, a.AWS)
 	if err != nil {
-		return settingUpPreviewSpinner.FailWithMessage("Failed to install program plugins", err)
+		return settingUpPreviewSpinner.FailWithMessage("Failed to set up the AWS provider", err)
 	}
 
-	// Set stack configuration specifying the AWS region to deploy
-	stack.SetConfig(ctx, "aws:region", auto.ConfigValue{Value: "us-west-2"})
-
 	// Stop the setting up spinner and create a spinner for the preview.
 	settingUpPreviewSpinner.Stop()
 	return nil
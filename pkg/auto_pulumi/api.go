@@ -2,6 +2,7 @@ package auto_pulumi
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
@@ -14,6 +15,55 @@ type APIRoute struct {
 	Route       string
 	PathToFiles string
 	CorsEnabled bool
+	Functions   FunctionSettings  `yaml:",omitempty"`
+	Variables   map[string]string `yaml:",omitempty"`
+
+	// Methods are the settings of the individual methods, keyed by method.
+	Methods map[string]MethodSettings `yaml:",omitempty"`
+}
+
+// MethodSettings configure the function of a single method of a route.
+type MethodSettings struct {
+	Functions FunctionSettings  `yaml:",omitempty"`
+	Variables map[string]string `yaml:",omitempty"`
+}
+
+// MethodSettings returns the settings of a method of the route.
+func (r APIRoute) MethodSettings(method string) MethodSettings {
+	for name, settings := range r.Methods {
+		if strings.EqualFold(name, method) {
+			return settings
+		}
+	}
+
+	return MethodSettings{}
+}
+
+// FunctionSettings configure Lambda functions. They can be set for the project, an
+// environment, a route, and a method. Zero values use the settings of the layer
+// below, and the AWS defaults if no layer sets them.
+//
+// Architecture and ephemeral storage can't be set since the pulumi-aws version
+// Stevie is built with doesn't support them. stevie validate reports them if they
+// are in the config.
+type FunctionSettings struct {
+	// Memory is the memory size of the function in MB.
+	Memory int `yaml:",omitempty"`
+
+	// Timeout is the timeout of the function in seconds.
+	Timeout int `yaml:",omitempty"`
+}
+
+// Override returns the settings with the values set in overrides replacing them.
+func (s FunctionSettings) Override(overrides FunctionSettings) FunctionSettings {
+	if overrides.Memory > 0 {
+		s.Memory = overrides.Memory
+	}
+	if overrides.Timeout > 0 {
+		s.Timeout = overrides.Timeout
+	}
+
+	return s
 }
 
 type APIEndpoint struct {
@@ -21,9 +71,38 @@ type APIEndpoint struct {
 	URL  pulumi.StringOutput
 }
 
-// readRoutesFromControllerDirectory reads the different controller methods in a given
+// AnyMethod is the method of a controller folder that handles every HTTP method the
+// route doesn't have a folder for.
+const AnyMethod = "any"
+
+// ControllerMethods are the methods a controller can have a folder for.
+var ControllerMethods = []string{"get", "post", "put", "patch", "delete", "head", "options", AnyMethod}
+
+// IsControllerMethod checks if a folder name is a controller method.
+func IsControllerMethod(name string) bool {
+	lowerCaseName := strings.ToLower(name)
+	for _, method := range ControllerMethods {
+		if lowerCaseName == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequestMethod returns the HTTP method of a request to a controller method. Requests
+// to the catch-all method are sent as GET.
+func RequestMethod(method string) string {
+	if strings.EqualFold(method, AnyMethod) {
+		return http.MethodGet
+	}
+
+	return strings.ToUpper(method)
+}
+
+// ReadRoutesFromControllerDirectory reads the different controller methods in a given
 // controller directory.
-func readRoutesFromControllerDirectory(controllerDirectoryPath string) ([]string, error) {
+func ReadRoutesFromControllerDirectory(controllerDirectoryPath string) ([]string, error) {
 	var methods []string
 
 	controllerDirectoryContents, err := utils.ReadDirectoryContents(controllerDirectoryPath)
@@ -32,10 +111,8 @@ func readRoutesFromControllerDirectory(controllerDirectoryPath string) ([]string
 	}
 
 	for _, contentName := range controllerDirectoryContents {
-		lowerCaseName := strings.ToLower(contentName)
-		switch lowerCaseName {
-		case "get", "post", "put", "delete":
-			methods = append(methods, lowerCaseName)
+		if IsControllerMethod(contentName) {
+			methods = append(methods, strings.ToLower(contentName))
 		}
 	}
 
@@ -46,7 +123,8 @@ func readRoutesFromControllerDirectory(controllerDirectoryPath string) ([]string
 	return methods, nil
 }
 
-func CreateAPI(ctx *pulumi.Context, projectName, environment string, routes []APIRoute) ([]APIEndpoint, error) {
+// CreateAPI creates the API Gateway and the endpoints for the routes.
+func CreateAPI(ctx *pulumi.Context, projectName, environment string, routes []APIRoute, functionEnvironment FunctionEnvironment) ([]APIEndpoint, error) {
 	apiName := fmt.Sprintf("%s-api", projectName)
 
 	// Create the API Gateway
@@ -55,15 +133,18 @@ func CreateAPI(ctx *pulumi.Context, projectName, environment string, routes []AP
 		return nil, err
 	}
 
+	// Routes share the resources for the parts of their paths they have in common.
+	resources := NewAPIResourceTree(ctx, gateway, routes)
+
 	// Create the endpoints for the controllers.
 	var endpoints []APIEndpoint
 	for _, route := range routes {
-		routeMethods, err := readRoutesFromControllerDirectory(route.PathToFiles)
+		routeMethods, err := ReadRoutesFromControllerDirectory(route.PathToFiles)
 		if err != nil {
 			return nil, err
 		}
 
-		endpointURL, err := CreateAPIEndpoint(ctx, gateway, environment, route, routeMethods)
+		endpointURL, err := CreateAPIEndpoint(ctx, gateway, resources, environment, route, routeMethods, functionEnvironment)
 		if err != nil {
 			return nil, err
 		}
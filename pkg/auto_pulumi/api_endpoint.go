@@ -12,23 +12,6 @@ import (
 
 var tmpDirName = "tmp"
 
-func createAPIGatewayResource(ctx *pulumi.Context, gateway *apigateway.RestApi, name string, route string) (*apigateway.Resource, error) {
-	apiName := fmt.Sprintf("%s-api-resource", name)
-	pathParts := strings.Split(route, "/")
-	pathPart := pathParts[len(pathParts)-1]
-
-	apiresource, err := apigateway.NewResource(ctx, apiName, &apigateway.ResourceArgs{
-		RestApi:  gateway.ID(),
-		PathPart: pulumi.String(pathPart),
-		ParentId: gateway.RootResourceId,
-	}, pulumi.DependsOn([]pulumi.Resource{gateway}))
-	if err != nil {
-		return nil, fmt.Errorf("Error creating API Gateway Resource for %s: %v", name, err)
-	}
-
-	return apiresource, nil
-}
-
 func createAPIGatewayRouteMethods(
 	ctx *pulumi.Context, apiResource *apigateway.Resource, gateway *apigateway.RestApi,
 	name string, methods []APIEndpointFunction,
@@ -201,8 +184,8 @@ type APIEndpointFunction struct {
 }
 
 func CreateAPIEndpoint(
-	ctx *pulumi.Context, gateway *apigateway.RestApi, environment string,
-	route APIRoute, methods []string,
+	ctx *pulumi.Context, gateway *apigateway.RestApi, resources *APIResourceTree, environment string,
+	route APIRoute, methods []string, functionEnvironment FunctionEnvironment,
 ) (pulumi.StringOutput, error) {
 	// Get the AWS account.
 	account, err := aws.GetCallerIdentity(ctx)
@@ -219,7 +202,7 @@ func CreateAPIEndpoint(
 	// Create the lambdas functions.
 	var lambdaFunctions []APIEndpointFunction
 	for _, method := range methods {
-		function, err := CreateRouteHandler(ctx, route, method)
+		function, err := CreateRouteHandler(ctx, route, method, functionEnvironment)
 		if err != nil {
 			return pulumi.StringOutput{}, err
 		}
@@ -230,8 +213,8 @@ func CreateAPIEndpoint(
 		})
 	}
 
-	// Add a resource to the API Gateway.
-	apiResource, err := createAPIGatewayResource(ctx, gateway, route.Name, route.Route)
+	// Get the resource for the route from the API Gateway's resource tree.
+	apiResource, err := resources.Resource(route.Route)
 	if err != nil {
 		return pulumi.StringOutput{}, err
 	}
@@ -0,0 +1,60 @@
+package auto_pulumi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StackResource describes a resource Stevie creates in a stack by its type and name.
+type StackResource struct {
+	Type string
+	Name string
+}
+
+// MethodResources returns the resources created for a method of a route. The names
+// match the ones used by CreateRouteHandler and CreateAPIEndpoint.
+func MethodResources(route APIRoute, method string) []StackResource {
+	lambdaName := fmt.Sprintf("%s-%s", route.Name, method)
+
+	return []StackResource{
+		{Type: "aws:iam/role:Role", Name: fmt.Sprintf("%s-task-exec-role", lambdaName)},
+		{Type: "aws:iam/rolePolicy:RolePolicy", Name: fmt.Sprintf("%s-lambda-log-policy", lambdaName)},
+		{Type: "aws:lambda/function:Function", Name: fmt.Sprintf("%s-lambda-function", lambdaName)},
+		{Type: "aws:apigateway/method:Method", Name: fmt.Sprintf("%s-api-%s-method", route.Name, method)},
+		{Type: "aws:apigateway/integration:Integration", Name: fmt.Sprintf("%s-%s-lambda-integration", route.Name, method)},
+		{Type: "aws:lambda/permission:Permission", Name: fmt.Sprintf("%s-%s-api-permission", route.Name, method)},
+	}
+}
+
+// RouteResources returns the resources created for a route and its methods. The
+// gateway resources for the parts of the route path other routes still use are
+// left out since they are kept.
+func RouteResources(route APIRoute, methods []string, otherRoutes []APIRoute) []StackResource {
+	var resources []StackResource
+	for _, method := range methods {
+		resources = append(resources, MethodResources(route, method)...)
+	}
+
+	resources = append(resources, StackResource{Type: "aws:apigateway/deployment:Deployment", Name: fmt.Sprintf("%s-api-deployment", route.Name)})
+
+	// Work out which resources of the tree only this route uses, deepest first.
+	usedPaths := make(map[string]bool)
+	for _, otherRoute := range otherRoutes {
+		resourcePath := ""
+		for _, segment := range RouteSegments(otherRoute.Route) {
+			resourcePath = resourcePath + "/" + segment
+			usedPaths[resourcePath] = true
+		}
+	}
+
+	segments := RouteSegments(route.Route)
+	for i := len(segments); i > 0; i-- {
+		resourcePath := "/" + strings.Join(segments[:i], "/")
+		if usedPaths[resourcePath] {
+			break
+		}
+		resources = append(resources, StackResource{Type: "aws:apigateway/resource:Resource", Name: apiResourceName(resourcePath)})
+	}
+
+	return resources
+}
@@ -0,0 +1,142 @@
+package auto_pulumi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
+)
+
+var (
+	// DefaultAWSRegion is the region used when the config doesn't set one.
+	DefaultAWSRegion = "us-west-2"
+
+	// DefaultAWSPluginVersion is the version of the AWS provider plugin used when
+	// the config doesn't set one. It matches the pulumi-aws SDK Stevie is built with.
+	DefaultAWSPluginVersion = "v3.16.0"
+
+	// ManagedStackConfigKeys are the stack config keys ApplyAWSSettings sets from
+	// the config on every run, mapped to the config key they are set from.
+	ManagedStackConfigKeys = map[string]string{
+		"aws:region":     "aws.region",
+		"aws:profile":    "aws.profile",
+		"aws:assumeRole": "aws.assumerole",
+	}
+)
+
+// AWSAssumeRoleSettings are the settings for a role the AWS provider assumes.
+type AWSAssumeRoleSettings struct {
+	RoleARN     string `yaml:"rolearn,omitempty" json:"roleArn,omitempty"`
+	SessionName string `yaml:"sessionname,omitempty" json:"sessionName,omitempty"`
+	ExternalID  string `yaml:"externalid,omitempty" json:"externalId,omitempty"`
+}
+
+// AWSSettings configure the AWS provider for an environment.
+type AWSSettings struct {
+	Region        string                `yaml:",omitempty"`
+	PluginVersion string                `yaml:"pluginversion,omitempty"`
+	Profile       string                `yaml:",omitempty"`
+	AssumeRole    AWSAssumeRoleSettings `yaml:"assumerole,omitempty"`
+
+	// DefaultTags are added to every resource that supports tags.
+	DefaultTags map[string]string `yaml:"defaulttags,omitempty"`
+}
+
+// WithDefaults fills in the region and plugin version if they aren't set.
+func (s AWSSettings) WithDefaults() AWSSettings {
+	if s.Region == "" {
+		s.Region = DefaultAWSRegion
+	}
+	if s.PluginVersion == "" {
+		s.PluginVersion = DefaultAWSPluginVersion
+	}
+
+	return s
+}
+
+// ApplyAWSSettings installs the AWS provider plugin and sets the provider config on
+// a stack. Settings that were removed from the config are removed from the stack.
+func ApplyAWSSettings(ctx context.Context, stack auto.Stack, settings AWSSettings) error {
+	settings = settings.WithDefaults()
+
+	err := stack.Workspace().InstallPlugin(ctx, "aws", settings.PluginVersion)
+	if err != nil {
+		return fmt.Errorf("Error installing aws plugin %s: %v", settings.PluginVersion, err)
+	}
+
+	err = stack.SetConfig(ctx, "aws:region", auto.ConfigValue{Value: settings.Region})
+	if err != nil {
+		return fmt.Errorf("Error setting aws:region: %v", err)
+	}
+
+	if settings.Profile != "" {
+		err = stack.SetConfig(ctx, "aws:profile", auto.ConfigValue{Value: settings.Profile})
+	} else {
+		err = stack.RemoveConfig(ctx, "aws:profile")
+	}
+	if err != nil {
+		return fmt.Errorf("Error setting aws:profile: %v", err)
+	}
+
+	if settings.AssumeRole.RoleARN != "" {
+		// Object config values are passed to the provider as JSON.
+		assumeRole, err := json.Marshal(settings.AssumeRole)
+		if err != nil {
+			return err
+		}
+		err = stack.SetConfig(ctx, "aws:assumeRole", auto.ConfigValue{Value: string(assumeRole)})
+	} else {
+		err = stack.RemoveConfig(ctx, "aws:assumeRole")
+	}
+	if err != nil {
+		return fmt.Errorf("Error setting aws:assumeRole: %v", err)
+	}
+
+	return nil
+}
+
+// RegisterDefaultTags adds tags to every resource created after it is called that
+// has a Tags argument. Tags set on a resource take precedence over the defaults.
+func RegisterDefaultTags(ctx *pulumi.Context, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return ctx.RegisterStackTransformation(func(args *pulumi.ResourceTransformationArgs) *pulumi.ResourceTransformationResult {
+		props := reflect.ValueOf(args.Props)
+		if props.Kind() != reflect.Ptr || props.IsNil() || props.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+
+		field := props.Elem().FieldByName("Tags")
+		if !field.IsValid() || !field.CanSet() || field.Type() != reflect.TypeOf((*pulumi.StringMapInput)(nil)).Elem() {
+			return nil
+		}
+
+		merged := pulumi.StringMap{}
+		for key, value := range tags {
+			merged[key] = pulumi.String(value)
+		}
+
+		if !field.IsNil() {
+			// Tags that are only known once deployed can't be merged so they are
+			// left as they are.
+			resourceTags, ok := field.Interface().(pulumi.StringMap)
+			if !ok {
+				return nil
+			}
+			for key, value := range resourceTags {
+				merged[key] = value
+			}
+		}
+
+		field.Set(reflect.ValueOf(pulumi.StringMapInput(merged)))
+		return &pulumi.ResourceTransformationResult{
+			Props: args.Props,
+			Opts:  args.Opts,
+		}
+	})
+}
@@ -61,9 +61,9 @@ func createLambdaLogPolicy(ctx *pulumi.Context, role *iam.Role, name string) (*i
 	return logPolicy, nil
 }
 
-// detectLambdaLanguage figures out the language of lambda by looking at the
+// DetectLambdaLanguage figures out the language of lambda by looking at the
 // the file extension.
-func detectLambdaLanguage(dirPath string) (string, error) {
+func DetectLambdaLanguage(dirPath string) (string, error) {
 	dirContents, err := utils.ReadDirectoryContents(dirPath)
 	if err != nil {
 		return "", err
@@ -96,10 +96,10 @@ func detectLambdaLanguage(dirPath string) (string, error) {
 }
 
 // createLambdaFunction creates a Lambda function
-func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.RolePolicy, route APIRoute, method string) (*lambda.Function, error) {
+func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.RolePolicy, route APIRoute, method string, functionEnvironment FunctionEnvironment) (*lambda.Function, error) {
 	// Determine the language of the function.
 	lambdaFilePath := path.Join(route.PathToFiles, method)
-	lambdaLanguage, err := detectLambdaLanguage(lambdaFilePath)
+	lambdaLanguage, err := DetectLambdaLanguage(lambdaFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +112,7 @@ func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.Ro
 	case "typescript":
 		lambdaRuntime = "nodejs12.x"
 		handlerName = fmt.Sprintf("%s-%s-handler.%sHandler", route.Name, method, method)
-		handlerZipFile, err = PackageTypeScriptLambda(tmpDirName, route.Name, method)
+		handlerZipFile, err = PackageTypeScriptLambda(tmpDirName, route.Name, route.PathToFiles, method)
 		if err != nil {
 			return nil, err
 		}
@@ -120,14 +120,14 @@ func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.Ro
 	case "go":
 		lambdaRuntime = "go1.x"
 		handlerName = fmt.Sprintf("%s-%s-handler", route.Name, method)
-		handlerZipFile, err = PackageGoLambda(tmpDirName, route.Name, method)
+		handlerZipFile, err = PackageGoLambda(tmpDirName, route.Name, route.PathToFiles, method)
 		if err != nil {
 			return nil, err
 		}
 	case "dotnet":
 		lambdaRuntime = "dotnetcore3.1"
 		handlerName = fmt.Sprintf("app::app.Functions::%s", utils.DashCaseToSentenceCase(method))
-		handlerZipFile, err = PackageDotNetLambda(tmpDirName, route.Name, method)
+		handlerZipFile, err = PackageDotNetLambda(tmpDirName, route.Name, route.PathToFiles, method)
 		if err != nil {
 			return nil, err
 		}
@@ -147,6 +147,16 @@ func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.Ro
 		Runtime: pulumi.String(lambdaRuntime),
 		Code:    pulumi.NewFileArchive(handlerFileName),
 	}
+	settings := functionEnvironment.SettingsForMethod(route, method)
+	if settings.Memory > 0 {
+		args.MemorySize = pulumi.IntPtr(settings.Memory)
+	}
+	if settings.Timeout > 0 {
+		args.Timeout = pulumi.IntPtr(settings.Timeout)
+	}
+	if variables := functionEnvironment.ForMethod(route, method); len(variables) > 0 {
+		args.Environment = &lambda.FunctionEnvironmentArgs{Variables: variables}
+	}
 
 	// Create the lambda using the args.
 	function, err := lambda.NewFunction(
@@ -163,7 +173,7 @@ func createLambdaFunction(ctx *pulumi.Context, role *iam.Role, logPolicy *iam.Ro
 }
 
 // CreateRouteHandler creates a Lambda function used for handling API Gateway requests.
-func CreateRouteHandler(ctx *pulumi.Context, route APIRoute, method string) (*lambda.Function, error) {
+func CreateRouteHandler(ctx *pulumi.Context, route APIRoute, method string, functionEnvironment FunctionEnvironment) (*lambda.Function, error) {
 	lambdaName := fmt.Sprintf("%s-%s", route.Name, method)
 
 	// Create the role.
@@ -179,7 +189,7 @@ func CreateRouteHandler(ctx *pulumi.Context, route APIRoute, method string) (*la
 	}
 
 	// Create the function.
-	function, err := createLambdaFunction(ctx, role, logPolicy, route, method)
+	function, err := createLambdaFunction(ctx, role, logPolicy, route, method, functionEnvironment)
 	if err != nil {
 		return nil, err
 	}
@@ -9,7 +9,7 @@ import (
 )
 
 // PackageDotNetLambda packages a DotNet Lambda for distribution.
-func PackageDotNetLambda(tmpDirectoryName, routeName, method string) (string, error) {
+func PackageDotNetLambda(tmpDirectoryName, routeName, controllerDirectory, method string) (string, error) {
 	name := fmt.Sprintf("%s-%s-handler", routeName, method)
 	lambdaDirectoryPath := path.Join(tmpDirectoryName, name)
 
@@ -19,7 +19,7 @@ func PackageDotNetLambda(tmpDirectoryName, routeName, method string) (string, er
 		return "", err
 	}
 
-	dotNetControllerDirectory := path.Join("app", "controllers", routeName, method)
+	dotNetControllerDirectory := path.Join(controllerDirectory, method)
 	err = utils.CopyDirectory(dotNetControllerDirectory, lambdaDirectoryPath, nil)
 	if err != nil {
 		return "", utils.NewErrorMessage("Error copy dotent lambda files", err)
@@ -35,7 +35,7 @@ func PackageDotNetLambda(tmpDirectoryName, routeName, method string) (string, er
 }
 
 // PackageGoLambda packages a Go Lambda for distribution.
-func PackageGoLambda(tmpDirectoryName, routeName, method string) (string, error) {
+func PackageGoLambda(tmpDirectoryName, routeName, controllerDirectory, method string) (string, error) {
 	name := fmt.Sprintf("%s-%s-handler", routeName, method)
 	lambdaDirectoryPath := path.Join(tmpDirectoryName, name)
 
@@ -45,7 +45,7 @@ func PackageGoLambda(tmpDirectoryName, routeName, method string) (string, error)
 		return "", err
 	}
 
-	goFilePath := path.Join("app", "controllers", routeName, method, fmt.Sprintf("%s.go", method))
+	goFilePath := path.Join(controllerDirectory, method, fmt.Sprintf("%s.go", method))
 	goFileOutputPath := path.Join(lambdaDirectoryPath, name)
 	err = os.Setenv("GOOS", "linux")
 	if err != nil {
@@ -78,7 +78,7 @@ func PackageGoLambda(tmpDirectoryName, routeName, method string) (string, error)
 }
 
 // PackageTypeScriptLambda packages a TypeScript Lambda for distribution.
-func PackageTypeScriptLambda(tmpDirectoryName string, routeName, method string) (string, error) {
+func PackageTypeScriptLambda(tmpDirectoryName string, routeName, controllerDirectory, method string) (string, error) {
 	name := fmt.Sprintf("%s-%s-handler", routeName, method)
 	zipOutputPath := path.Join(tmpDirectoryName, fmt.Sprintf("%s.zip", name))
 	lambdaDirectoryPath := path.Join(tmpDirectoryName, name)
@@ -91,12 +91,11 @@ func PackageTypeScriptLambda(tmpDirectoryName string, routeName, method string)
 
 	// Build the lambda. Technically this is repeated for method but eventually it would
 	// be good to support a package.json per method so we only package up what is need.
-	routeCodeDir := fmt.Sprintf("app/controllers/%s", routeName)
-	_, err = utils.RunCommand("yarn", []string{"--cwd", routeCodeDir})
-	_, err = utils.RunCommand("yarn", []string{"--cwd", routeCodeDir, "build"})
+	_, err = utils.RunCommand("yarn", []string{"--cwd", controllerDirectory})
+	_, err = utils.RunCommand("yarn", []string{"--cwd", controllerDirectory, "build"})
 
 	// Copy the files to package into the lambda directory.
-	handlerFileSource := fmt.Sprintf("%s/bin/%s/%s.js", routeCodeDir, method, method)
+	handlerFileSource := fmt.Sprintf("%s/bin/%s/%s.js", controllerDirectory, method, method)
 	handlerFileDestination := path.Join(lambdaDirectoryPath, fmt.Sprintf("%s.js", name))
 	err = utils.CopyFile(handlerFileSource, handlerFileDestination)
 	if err != nil {
@@ -104,7 +103,7 @@ func PackageTypeScriptLambda(tmpDirectoryName string, routeName, method string)
 	}
 
 	// Add the utils package.
-	utilsName := path.Join("app", "controllers", routeName, "stevie-utils")
+	utilsName := path.Join(controllerDirectory, "stevie-utils")
 	utilsPath := path.Join(lambdaDirectoryPath, "stevie-utils")
 	err = utils.CreateNewDirectory(utilsPath)
 	if err != nil {
@@ -118,7 +117,7 @@ func PackageTypeScriptLambda(tmpDirectoryName string, routeName, method string)
 
 	// Add the package.json for the project and install the dependencies.
 	packageJSONDestination := path.Join(lambdaDirectoryPath, "package.json")
-	packageJSONOrigin := path.Join("app", "controllers", routeName, "package.json")
+	packageJSONOrigin := path.Join(controllerDirectory, "package.json")
 	err = utils.CopyFile(packageJSONOrigin, packageJSONDestination)
 	if err != nil {
 		return "", err
@@ -0,0 +1,35 @@
+package auto_pulumi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
+)
+
+// GetStageURL finds the URL of the API Gateway stage for a stack from the endpoint
+// URLs it exports for the routes.
+func GetStageURL(ctx context.Context, stack auto.Stack, routes []APIRoute) (string, error) {
+	outputs, err := stack.Outputs(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Error reading stack outputs: %v", err)
+	}
+
+	// Each route exports its endpoint URL, which is the stage URL followed by the route.
+	for _, route := range routes {
+		output, ok := outputs[route.Name]
+		if !ok {
+			continue
+		}
+
+		endpointURL, ok := output.Value.(string)
+		if !ok || !strings.HasSuffix(endpointURL, route.Route) {
+			continue
+		}
+
+		return strings.TrimSuffix(endpointURL, route.Route), nil
+	}
+
+	return "", fmt.Errorf("No endpoint URLs found in the stack outputs. Has the stack been deployed?")
+}
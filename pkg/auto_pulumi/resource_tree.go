@@ -0,0 +1,101 @@
+package auto_pulumi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v3/go/aws/apigateway"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// APIResourceTree creates the API Gateway resources for the route paths. Routes
+// that share a prefix share its resources, so /users and /users/{id} both use the
+// same /users resource.
+type APIResourceTree struct {
+	ctx     *pulumi.Context
+	gateway *apigateway.RestApi
+
+	// resources are the created resources keyed by their path.
+	resources map[string]*apigateway.Resource
+
+	// aliases are the names single segment routes used to create their resource
+	// with, so existing stacks keep their resources.
+	aliases map[string]string
+}
+
+// NewAPIResourceTree creates a resource tree for the routes on a gateway.
+func NewAPIResourceTree(ctx *pulumi.Context, gateway *apigateway.RestApi, routes []APIRoute) *APIResourceTree {
+	aliases := make(map[string]string)
+	for _, route := range routes {
+		segments := RouteSegments(route.Route)
+		if len(segments) == 1 {
+			aliases["/"+segments[0]] = fmt.Sprintf("%s-api-resource", route.Name)
+		}
+	}
+
+	return &APIResourceTree{
+		ctx:       ctx,
+		gateway:   gateway,
+		resources: make(map[string]*apigateway.Resource),
+		aliases:   aliases,
+	}
+}
+
+// Resource returns the resource for a route path, creating it and any of its
+// parents that don't exist yet.
+func (t *APIResourceTree) Resource(route string) (*apigateway.Resource, error) {
+	err := ValidateRoutePath(route)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource *apigateway.Resource
+	resourcePath := ""
+	for _, segment := range RouteSegments(route) {
+		parentID := t.gateway.RootResourceId
+		if resource != nil {
+			parentID = resource.ID().ToStringOutput()
+		}
+
+		resourcePath = resourcePath + "/" + segment
+		existingResource, ok := t.resources[resourcePath]
+		if ok {
+			resource = existingResource
+			continue
+		}
+
+		resource, err = t.createResource(resourcePath, segment, parentID)
+		if err != nil {
+			return nil, err
+		}
+		t.resources[resourcePath] = resource
+	}
+
+	return resource, nil
+}
+
+// apiResourceName returns the name of the resource for a path in the tree.
+func apiResourceName(resourcePath string) string {
+	return fmt.Sprintf("%s-api-resource", strings.TrimPrefix(resourcePath, "/"))
+}
+
+// createResource creates the resource for a path segment under its parent.
+func (t *APIResourceTree) createResource(resourcePath, segment string, parentID pulumi.StringInput) (*apigateway.Resource, error) {
+	resourceName := apiResourceName(resourcePath)
+
+	options := []pulumi.ResourceOption{pulumi.DependsOn([]pulumi.Resource{t.gateway})}
+	if alias, ok := t.aliases[resourcePath]; ok && alias != resourceName {
+		options = append(options, pulumi.Aliases([]pulumi.Alias{{Name: pulumi.String(alias)}}))
+	}
+
+	resource, err := apigateway.NewResource(t.ctx, resourceName, &apigateway.ResourceArgs{
+		RestApi:  t.gateway.ID(),
+		PathPart: pulumi.String(segment),
+		ParentId: parentID,
+	}, options...)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating API Gateway Resource for %s: %v", resourcePath, err)
+	}
+
+	return resource, nil
+}
@@ -0,0 +1,133 @@
+package auto_pulumi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// routeSegmentPattern matches a plain segment of a route path.
+	routeSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+	// routeParameterPattern matches a {param} or greedy {param+} segment of a route path.
+	routeParameterPattern = regexp.MustCompile(`^\{([A-Za-z_][A-Za-z0-9_]*)(\+?)\}$`)
+
+	// reservedControllerFolders are folders inside a controller directory that aren't
+	// nested controllers, so no route segment can use their names.
+	reservedControllerFolders = []string{"bin", "obj", "node_modules", "stevie-utils", "events"}
+)
+
+// RouteSegments splits a route path, like /users/{id}, into its segments.
+func RouteSegments(route string) []string {
+	trimmedRoute := strings.Trim(route, "/")
+	if trimmedRoute == "" {
+		return nil
+	}
+
+	return strings.Split(trimmedRoute, "/")
+}
+
+// RouteParameter returns the name of the path parameter a route segment is for and
+// whether it is greedy. The name is empty if the segment isn't a parameter.
+func RouteParameter(segment string) (string, bool) {
+	match := routeParameterPattern.FindStringSubmatch(segment)
+	if match == nil {
+		return "", false
+	}
+
+	return match[1], match[2] == "+"
+}
+
+// RouteParameters returns the names of the path parameters of a route in order.
+func RouteParameters(route string) []string {
+	var parameters []string
+	for _, segment := range RouteSegments(route) {
+		if parameter, _ := RouteParameter(segment); parameter != "" {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return parameters
+}
+
+// ValidateRoutePath checks a route path is one API Gateway can serve. Segments are
+// either plain, a {param}, or a greedy {param+} as the last segment. Nested
+// controllers live inside the folders of their parents, so plain segments can't be
+// named after a controller method or another folder a controller has.
+func ValidateRoutePath(route string) error {
+	if !strings.HasPrefix(route, "/") {
+		return fmt.Errorf("route %q must start with /", route)
+	}
+
+	segments := RouteSegments(route)
+	if len(segments) == 0 {
+		return fmt.Errorf("route %q must have at least one path segment", route)
+	}
+
+	parameters := make(map[string]bool)
+	for i, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("route %q has an empty path segment", route)
+		}
+
+		if routeSegmentPattern.MatchString(segment) {
+			if isReservedRouteSegment(segment) {
+				return fmt.Errorf("route %q can't have a path segment named %q, it is used for the folders of a controller", route, segment)
+			}
+			continue
+		}
+
+		parameter, greedy := RouteParameter(segment)
+		if parameter == "" {
+			return fmt.Errorf("route %q has an invalid path segment %q", route, segment)
+		}
+		if greedy && i != len(segments)-1 {
+			return fmt.Errorf("route %q can only have a greedy path parameter as its last segment", route)
+		}
+		if parameters[parameter] {
+			return fmt.Errorf("route %q uses the path parameter %s more than once", route, parameter)
+		}
+		parameters[parameter] = true
+	}
+
+	return nil
+}
+
+// isReservedRouteSegment checks if a plain route segment has the name of a folder
+// inside a controller directory. Names are matched without case like method folders.
+func isReservedRouteSegment(segment string) bool {
+	if IsControllerMethod(segment) {
+		return true
+	}
+
+	for _, folder := range reservedControllerFolders {
+		if strings.EqualFold(segment, folder) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckRouteParameters checks two routes name their path parameters the same way
+// below the resources they share. API Gateway only allows one path parameter below
+// each resource, so /users/{id} and /users/{userId}/posts can't both be served.
+func CheckRouteParameters(route, other string) error {
+	segments := RouteSegments(route)
+	otherSegments := RouteSegments(other)
+	for i := 0; i < len(segments) && i < len(otherSegments); i++ {
+		parameter, _ := RouteParameter(segments[i])
+		otherParameter, _ := RouteParameter(otherSegments[i])
+		if parameter != "" && otherParameter != "" && segments[i] != otherSegments[i] {
+			return fmt.Errorf("route %q uses %s where route %q uses %s", route, segments[i], other, otherSegments[i])
+		}
+
+		// The routes don't share any more resources once a segment differs.
+		if segments[i] != otherSegments[i] {
+			break
+		}
+	}
+
+	return nil
+}
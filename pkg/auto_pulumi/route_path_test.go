@@ -0,0 +1,40 @@
+package auto_pulumi
+
+import "testing"
+
+func TestValidateRoutePath(t *testing.T) {
+	tests := []struct {
+		route   string
+		isValid bool
+	}{
+		{route: "/users", isValid: true},
+		{route: "/users/{id}/posts", isValid: true},
+		{route: "/files/{path+}", isValid: true},
+		{route: "/getters", isValid: true},
+		{route: "users", isValid: false},
+		{route: "/", isValid: false},
+		{route: "/users//posts", isValid: false},
+		{route: "/files/{path+}/meta", isValid: false},
+		{route: "/users/{id}/friends/{id}", isValid: false},
+		{route: "/users/get", isValid: false},
+		{route: "/users/POST", isValid: false},
+		{route: "/any", isValid: false},
+		{route: "/users/bin", isValid: false},
+		{route: "/users/obj", isValid: false},
+		{route: "/node_modules", isValid: false},
+		{route: "/users/stevie-utils", isValid: false},
+		{route: "/users/events", isValid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.route, func(t *testing.T) {
+			err := ValidateRoutePath(test.route)
+			if test.isValid && err != nil {
+				t.Errorf("Expected %s to be valid: %v", test.route, err)
+			}
+			if !test.isValid && err == nil {
+				t.Errorf("Expected %s to be invalid", test.route)
+			}
+		})
+	}
+}
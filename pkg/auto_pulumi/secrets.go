@@ -0,0 +1,114 @@
+package auto_pulumi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
+)
+
+// SecretsNamespace is the stack config namespace secrets are stored in.
+const SecretsNamespace = "secrets"
+
+// secretConfigKey returns the stack config key of a secret.
+func secretConfigKey(name string) string {
+	return fmt.Sprintf("%s:%s", SecretsNamespace, name)
+}
+
+// ReadSecrets reads the secrets of a stack.
+func ReadSecrets(ctx context.Context, stack auto.Stack) (map[string]string, error) {
+	stackConfig, err := stack.GetAllConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading stack config: %v", err)
+	}
+
+	secrets := make(map[string]string)
+	prefix := secretConfigKey("")
+	for key, value := range stackConfig {
+		if strings.HasPrefix(key, prefix) {
+			secrets[strings.TrimPrefix(key, prefix)] = value.Value
+		}
+	}
+
+	return secrets, nil
+}
+
+// SecretNames returns the sorted names of the secrets of a stack.
+func SecretNames(ctx context.Context, stack auto.Stack) ([]string, error) {
+	secrets, err := ReadSecrets(ctx, stack)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// SetSecret stores a secret in the stack config. Pulumi encrypts the value.
+func SetSecret(ctx context.Context, stack auto.Stack, name, value string) error {
+	err := ValidateVariableName(name)
+	if err != nil {
+		return err
+	}
+
+	err = stack.SetConfig(ctx, secretConfigKey(name), auto.ConfigValue{Value: value, Secret: true})
+	if err != nil {
+		return fmt.Errorf("Error setting secret %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// GetSecret reads the value of a secret.
+func GetSecret(ctx context.Context, stack auto.Stack, name string) (string, error) {
+	secrets, err := ReadSecrets(ctx, stack)
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secrets[name]
+	if !ok {
+		return "", fmt.Errorf("Secret %s is not set", name)
+	}
+
+	return value, nil
+}
+
+// RemoveSecret removes a secret from the stack config.
+func RemoveSecret(ctx context.Context, stack auto.Stack, name string) error {
+	secrets, err := ReadSecrets(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[name]; !ok {
+		return fmt.Errorf("Secret %s is not set", name)
+	}
+
+	err = stack.RemoveConfig(ctx, secretConfigKey(name))
+	if err != nil {
+		return fmt.Errorf("Error removing secret %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// SecretEnvironment reads secrets in a Pulumi program as environment variables for
+// the Lambda functions. The values stay secret outputs so they are never shown in
+// plaintext.
+func SecretEnvironment(ctx *pulumi.Context, names []string) pulumi.StringMap {
+	variables := pulumi.StringMap{}
+	for _, name := range names {
+		variables[name] = config.RequireSecret(ctx, secretConfigKey(name))
+	}
+
+	return variables
+}
@@ -2,10 +2,99 @@ package auto_pulumi
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v2/go/x/auto"
 )
 
+// StackConfigDirectory is the directory the Pulumi project and stack config files
+// are kept in. Stack config, like secrets, is stored there so it is still around the
+// next time a command runs. Secrets are encrypted by Pulumi so the directory can be
+// checked in.
+var StackConfigDirectory = "stacks"
+
+// stackWorkDir creates the stack config directory and returns its absolute path.
+func stackWorkDir() (string, error) {
+	workDir, err := filepath.Abs(StackConfigDirectory)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(workDir, os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("Error creating %s directory: %v", StackConfigDirectory, err)
+	}
+
+	return workDir, nil
+}
+
+// UpsertStack creates the stack for an environment if it doesn't exist and selects it.
+func UpsertStack(ctx context.Context, stackName, projectName string, program pulumi.RunFunc) (auto.Stack, error) {
+	workDir, err := stackWorkDir()
+	if err != nil {
+		return auto.Stack{}, err
+	}
+
+	return auto.UpsertStackInlineSource(ctx, stackName, projectName, program, auto.WorkDir(workDir))
+}
+
+// ListStacks returns the summaries of the stacks of a project keyed by the
+// environment they are for.
+func ListStacks(ctx context.Context, projectName string) (map[string]auto.StackSummary, error) {
+	workDir, err := stackWorkDir()
+	if err != nil {
+		return nil, err
+	}
+
+	project := workspace.Project{
+		Name:    tokens.PackageName(projectName),
+		Runtime: workspace.NewProjectRuntimeInfo("go", nil),
+	}
+	projectWorkspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(workDir), auto.Project(project))
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := projectWorkspace.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stack names can include the owner and project so only keep the last part.
+	stacks := make(map[string]auto.StackSummary)
+	for _, summary := range summaries {
+		nameParts := strings.Split(summary.Name, "/")
+		stacks[nameParts[len(nameParts)-1]] = summary
+	}
+
+	return stacks, nil
+}
+
+// StackResourceCount returns how many resources are deployed by a stack.
+func StackResourceCount(ctx context.Context, stack auto.Stack) (int, error) {
+	info, err := stack.Info(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.ResourceCount == nil {
+		return 0, nil
+	}
+
+	return *info.ResourceCount, nil
+}
+
+// RemoveStack deletes a stack along with its config and history.
+func RemoveStack(ctx context.Context, stack auto.Stack) error {
+	return stack.Workspace().RemoveStack(ctx, stack.Name())
+}
+
 // createPulumiProject creates a new Pulumi project and the appropriate
 // stacks for each environment.
 //
@@ -14,7 +103,7 @@ import (
 func CreatePulumiProject(ctx context.Context, owner string, projectName string, env string, description string) (string, error) {
 	// Loop over the environments are create
 	stackName := auto.FullyQualifiedStackName(owner, projectName, env)
-	_, err := auto.UpsertStackInlineSource(ctx, stackName, projectName, nil)
+	_, err := UpsertStack(ctx, stackName, projectName, nil)
 	if err != nil {
 		return "", err
 	}
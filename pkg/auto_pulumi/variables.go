@@ -0,0 +1,122 @@
+package auto_pulumi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// BuiltInVariablePrefix is the prefix of the environment variables stevie sets on
+// every function.
+const BuiltInVariablePrefix = "STEVIE_"
+
+// variableNamePattern matches the names that can be used as environment variables.
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedVariableNames are environment variables Lambda sets itself.
+var reservedVariableNames = []string{
+	"_HANDLER",
+	"_X_AMZN_TRACE_ID",
+	"AWS_REGION",
+	"AWS_EXECUTION_ENV",
+	"AWS_LAMBDA_FUNCTION_NAME",
+	"AWS_LAMBDA_FUNCTION_MEMORY_SIZE",
+	"AWS_LAMBDA_FUNCTION_VERSION",
+	"AWS_LAMBDA_LOG_GROUP_NAME",
+	"AWS_LAMBDA_LOG_STREAM_NAME",
+	"AWS_LAMBDA_RUNTIME_API",
+	"AWS_ACCESS_KEY_ID",
+	"AWS_SECRET_ACCESS_KEY",
+	"AWS_SESSION_TOKEN",
+	"LAMBDA_TASK_ROOT",
+	"LAMBDA_RUNTIME_DIR",
+}
+
+// ValidateVariableName checks a name can be used as an environment variable of a
+// function.
+func ValidateVariableName(name string) error {
+	if !variableNamePattern.MatchString(name) {
+		return fmt.Errorf("%q can only contain letters, numbers, and underscores and can't start with a number", name)
+	}
+
+	if strings.HasPrefix(strings.ToUpper(name), BuiltInVariablePrefix) {
+		return fmt.Errorf("%s is reserved, variables starting with %s are set by stevie", name, BuiltInVariablePrefix)
+	}
+
+	for _, reservedName := range reservedVariableNames {
+		if strings.EqualFold(name, reservedName) {
+			return fmt.Errorf("%s is reserved by AWS Lambda", name)
+		}
+	}
+
+	return nil
+}
+
+// BuiltInVariables returns the variables stevie sets on the function for a method
+// of a route.
+func BuiltInVariables(project, environment string, route APIRoute, method string) map[string]string {
+	return map[string]string{
+		BuiltInVariablePrefix + "PROJECT": project,
+		BuiltInVariablePrefix + "ENV":     environment,
+		BuiltInVariablePrefix + "ROUTE":   route.Name,
+		BuiltInVariablePrefix + "METHOD":  strings.ToUpper(method),
+	}
+}
+
+// FunctionVariables returns the environment variables of the function for a method
+// of a route. The project and environment variables are overridden by the route's
+// variables, which are overridden by the method's. The built-in variables are
+// always set.
+func FunctionVariables(project, environment string, variables map[string]string, route APIRoute, method string) map[string]string {
+	functionVariables := make(map[string]string)
+	layers := []map[string]string{
+		variables,
+		route.Variables,
+		route.MethodSettings(method).Variables,
+		BuiltInVariables(project, environment, route, method),
+	}
+	for _, layer := range layers {
+		for key, value := range layer {
+			functionVariables[key] = value
+		}
+	}
+
+	return functionVariables
+}
+
+// FunctionEnvironment is what the Lambda functions of an API are run with.
+type FunctionEnvironment struct {
+	Project     string
+	Environment string
+
+	// Variables are the project and environment variables.
+	Variables map[string]string
+
+	// Secrets are set on every function and override the variables.
+	Secrets pulumi.StringMap
+
+	// Functions are the project and environment function settings.
+	Functions FunctionSettings
+}
+
+// SettingsForMethod returns the function settings for a method of a route. The
+// route's settings override the project and environment settings and are
+// overridden by the method's.
+func (e FunctionEnvironment) SettingsForMethod(route APIRoute, method string) FunctionSettings {
+	return e.Functions.Override(route.Functions).Override(route.MethodSettings(method).Functions)
+}
+
+// ForMethod returns the environment variables of the function for a method of a route.
+func (e FunctionEnvironment) ForMethod(route APIRoute, method string) pulumi.StringMap {
+	variables := pulumi.StringMap{}
+	for key, value := range FunctionVariables(e.Project, e.Environment, e.Variables, route, method) {
+		variables[key] = pulumi.String(value)
+	}
+	for key, value := range e.Secrets {
+		variables[key] = value
+	}
+
+	return variables
+}
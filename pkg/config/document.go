@@ -0,0 +1,340 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/zchase/stevie/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// LockTimeout is how long to wait for another process to finish writing a
+	// config file.
+	LockTimeout = 5 * time.Second
+
+	// staleLockAge is how old a lock file is before it is assumed to be left over
+	// from a process that crashed.
+	staleLockAge = 30 * time.Second
+)
+
+// lockFilePath returns the path of the lock file for a config file.
+func lockFilePath(filePath string) string {
+	return path.Join(path.Dir(filePath), fmt.Sprintf(".%s.lock", path.Base(filePath)))
+}
+
+// lockFile takes the lock for a config file and returns a function that releases it.
+// The lock is a file created next to the config file so it works on every platform.
+func lockFile(filePath string) (func(), error) {
+	lockPath := lockFilePath(filePath)
+	deadline := time.Now().Add(LockTimeout)
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("Error locking config file %s: %v", filePath, err)
+		}
+
+		// Remove a lock left behind by a process that didn't clean up.
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			os.Remove(lockPath)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Config file %s is locked by another process. Delete %s if no other stevie command is running", filePath, lockPath)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// WriteFile writes a config file while holding its lock. The file is replaced
+// atomically so readers never see a partly written file.
+func WriteFile(filePath string, contents []byte) error {
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return utils.WriteFileAtomically(filePath, contents)
+}
+
+// Document is a YAML config file. It keeps the parsed nodes of the file so changes
+// can be written back without losing comments or the order of keys.
+type Document struct {
+	Path string
+
+	document *yaml.Node
+}
+
+// LoadDocument reads a config file. A file that doesn't exist is loaded as an
+// empty document.
+func LoadDocument(filePath string) (*Document, error) {
+	document := &Document{Path: filePath, document: &yaml.Node{Kind: yaml.DocumentNode}}
+
+	contents, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return document, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	err = yaml.Unmarshal(contents, document.document)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+	if document.document.Kind == 0 {
+		document.document.Kind = yaml.DocumentNode
+	}
+
+	return document, nil
+}
+
+// root returns the root node of the document, or nil if it is empty.
+func (d *Document) root() *yaml.Node {
+	if len(d.document.Content) == 0 {
+		return nil
+	}
+
+	return d.document.Content[0]
+}
+
+// Decode decodes the document into a value.
+func (d *Document) Decode(value interface{}) error {
+	root := d.root()
+	if root == nil {
+		return nil
+	}
+
+	err := root.Decode(value)
+	if err != nil {
+		return fmt.Errorf("Error reading config file %s: %v", d.Path, err)
+	}
+
+	return nil
+}
+
+// Update applies the changes between two versions of a value to the document. Only
+// the parts that changed are touched so comments, key order, and keys the value
+// doesn't know about are kept.
+func (d *Document) Update(before, after interface{}) error {
+	var beforeNode yaml.Node
+	err := beforeNode.Encode(before)
+	if err != nil {
+		return err
+	}
+
+	return d.update(&beforeNode, after)
+}
+
+// update applies the changes from an encoded value to a new version of it.
+func (d *Document) update(before *yaml.Node, after interface{}) error {
+	var afterNode yaml.Node
+	err := afterNode.Encode(after)
+	if err != nil {
+		return err
+	}
+
+	root := d.root()
+	if root == nil {
+		d.document.Content = []*yaml.Node{&afterNode}
+		return nil
+	}
+
+	d.document.Content[0] = applyChanges(root, before, &afterNode)
+	return nil
+}
+
+// Contents returns the document as YAML.
+func (d *Document) Contents() ([]byte, error) {
+	if d.root() == nil {
+		return []byte{}, nil
+	}
+
+	contents, err := encodeYAMLDocument(d.document)
+	return []byte(contents), err
+}
+
+// Save writes the document to its file.
+func (d *Document) Save() error {
+	contents, err := d.Contents()
+	if err != nil {
+		return err
+	}
+
+	return utils.WriteFileAtomically(d.Path, contents)
+}
+
+// mutateFile loads a config file into a value, lets the mutate function change the
+// value, and writes the changes back. The file is locked the whole time so other
+// writers can't interleave.
+func mutateFile(filePath string, value interface{}, mutate func() error) error {
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	document, err := LoadDocument(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = document.Decode(value)
+	if err != nil {
+		return err
+	}
+
+	// Keep a copy of the value before the change to work out what changed.
+	var before yaml.Node
+	err = before.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	err = mutate()
+	if err != nil {
+		return err
+	}
+
+	err = document.update(&before, value)
+	if err != nil {
+		return err
+	}
+
+	return document.Save()
+}
+
+// nodesEqual checks if two nodes hold the same data.
+func nodesEqual(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind || a.Value != b.Value || len(a.Content) != len(b.Content) {
+		return false
+	}
+	if a.Kind == yaml.ScalarNode && a.ShortTag() != b.ShortTag() {
+		return false
+	}
+
+	for i := range a.Content {
+		if !nodesEqual(a.Content[i], b.Content[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keepComments copies the comments of the node being replaced onto its replacement.
+func keepComments(replaced, replacement *yaml.Node) *yaml.Node {
+	node := *replacement
+	node.HeadComment = replaced.HeadComment
+	node.LineComment = replaced.LineComment
+	node.FootComment = replaced.FootComment
+	return &node
+}
+
+// mappingValue returns the value for a key in a mapping node, or nil.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// itemName returns the name key of a list item, or an empty string.
+func itemName(item *yaml.Node) string {
+	if item.Kind != yaml.MappingNode {
+		return ""
+	}
+
+	name := mappingValue(item, "name")
+	if name == nil {
+		return ""
+	}
+
+	return name.Value
+}
+
+// applyChanges applies the changes from before to after onto a node of the file.
+// before is what the node decoded to, so anything in the file that isn't in before
+// is left alone.
+func applyChanges(target, before, after *yaml.Node) *yaml.Node {
+	if nodesEqual(before, after) {
+		return target
+	}
+	if target.Kind != after.Kind || before.Kind != after.Kind {
+		return keepComments(target, after)
+	}
+
+	switch after.Kind {
+	case yaml.MappingNode:
+		merged := *target
+		merged.Content = nil
+
+		// Update or remove the keys of the file.
+		for i := 0; i+1 < len(target.Content); i += 2 {
+			key, value := target.Content[i], target.Content[i+1]
+			beforeValue := mappingValue(before, key.Value)
+			afterValue := mappingValue(after, key.Value)
+
+			switch {
+			case beforeValue == nil && afterValue == nil:
+				// The value doesn't know about this key.
+			case afterValue == nil:
+				continue
+			case beforeValue == nil:
+				value = keepComments(value, afterValue)
+			default:
+				value = applyChanges(value, beforeValue, afterValue)
+			}
+			merged.Content = append(merged.Content, key, value)
+		}
+
+		// Add the new keys.
+		for i := 0; i+1 < len(after.Content); i += 2 {
+			if mappingValue(target, after.Content[i].Value) == nil {
+				merged.Content = append(merged.Content, after.Content[i], after.Content[i+1])
+			}
+		}
+		return &merged
+	case yaml.SequenceNode:
+		merged := *target
+		merged.Content = nil
+
+		// Items are matched by name if they have one, otherwise by position. The
+		// items of before line up with the items of the file.
+		for i, item := range after.Content {
+			match := -1
+			if name := itemName(item); name != "" {
+				for j, beforeItem := range before.Content {
+					if itemName(beforeItem) == name {
+						match = j
+						break
+					}
+				}
+			} else if i < len(before.Content) {
+				match = i
+			}
+
+			if match != -1 && match < len(target.Content) {
+				merged.Content = append(merged.Content, applyChanges(target.Content[match], before.Content[match], item))
+			} else {
+				merged.Content = append(merged.Content, item)
+			}
+		}
+		return &merged
+	default:
+		return keepComments(target, after)
+	}
+}
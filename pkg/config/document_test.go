@@ -0,0 +1,247 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+)
+
+// newTestConfigDirectory creates a config directory with a base config file. The
+// returned function removes the directory.
+func newTestConfigDirectory(t *testing.T, base string) (string, func()) {
+	t.Helper()
+
+	configPath, err := ioutil.TempDir("", "stevie-config")
+	if err != nil {
+		t.Fatalf("Error creating config directory: %v", err)
+	}
+	cleanup := func() { os.RemoveAll(configPath) }
+
+	writeTestFile(t, BaseFilePath(configPath), base)
+	return configPath, cleanup
+}
+
+// writeTestFile writes a file for a test.
+func writeTestFile(t *testing.T, filePath, contents string) {
+	t.Helper()
+
+	err := ioutil.WriteFile(filePath, []byte(contents), 0644)
+	if err != nil {
+		t.Fatalf("Error writing %s: %v", filePath, err)
+	}
+}
+
+// readTestFile reads a file for a test.
+func readTestFile(t *testing.T, filePath string) string {
+	t.Helper()
+
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Error reading %s: %v", filePath, err)
+	}
+
+	return string(contents)
+}
+
+const testBaseConfig = `# The project.
+name: Demo App
+dashcasename: demo-app
+description: A demo # shown in the console
+# Settings other tools read.
+custom:
+  owner: platform
+routes:
+  # The users API.
+  - name: users
+    route: /users
+    pathtofiles: app/controllers/users
+    corsenabled: false
+`
+
+func TestMutateProjectKeepsCommentsAndKeyOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(project *Project) error
+		want   string
+	}{
+		{
+			name:   "no change",
+			mutate: func(project *Project) error { return nil },
+			want:   testBaseConfig,
+		},
+		{
+			name: "change a value",
+			mutate: func(project *Project) error {
+				project.Description = "The demo"
+				return nil
+			},
+			want: strings.Replace(testBaseConfig, "description: A demo # shown", "description: The demo # shown", 1),
+		},
+		{
+			name: "change a route",
+			mutate: func(project *Project) error {
+				project.Routes[0].CorsEnabled = true
+				return nil
+			},
+			want: strings.Replace(testBaseConfig, "corsenabled: false", "corsenabled: true", 1),
+		},
+		{
+			name: "add a route",
+			mutate: func(project *Project) error {
+				project.Routes = append(project.Routes, auto_pulumi.APIRoute{Name: "posts", Route: "/posts", PathToFiles: "app/controllers/posts"})
+				return nil
+			},
+			want: testBaseConfig + `  - name: posts
+    route: /posts
+    pathtofiles: app/controllers/posts
+    corsenabled: false
+`,
+		},
+		{
+			name: "add a key",
+			mutate: func(project *Project) error {
+				project.Variables = map[string]string{"STAGE": "dev"}
+				return nil
+			},
+			want: testBaseConfig + "variables:\n  STAGE: dev\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configPath, cleanup := newTestConfigDirectory(t, testBaseConfig)
+			defer cleanup()
+
+			err := MutateProject(configPath, test.mutate)
+			if err != nil {
+				t.Fatalf("Error changing the config: %v", err)
+			}
+
+			if got := readTestFile(t, BaseFilePath(configPath)); got != test.want {
+				t.Errorf("Config is\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMutateProjectConcurrently(t *testing.T) {
+	configPath, cleanup := newTestConfigDirectory(t, testBaseConfig)
+	defer cleanup()
+
+	const writers = 20
+	var wait sync.WaitGroup
+	errs := make(chan error, writers)
+	for i := 0; i < writers; i++ {
+		wait.Add(1)
+		go func(i int) {
+			defer wait.Done()
+			errs <- MutateProject(configPath, func(project *Project) error {
+				// Give the other writers a chance to run in the middle of the change.
+				time.Sleep(time.Millisecond)
+				if project.Variables == nil {
+					project.Variables = make(map[string]string)
+				}
+				project.Variables[fmt.Sprintf("WRITER_%d", i)] = "done"
+				return nil
+			})
+		}(i)
+	}
+	wait.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Error changing the config: %v", err)
+		}
+	}
+
+	project, err := LoadProject(configPath)
+	if err != nil {
+		t.Fatalf("Error reading the config: %v", err)
+	}
+	if len(project.Variables) != writers {
+		t.Errorf("Config has %d of the %d changes: %v", len(project.Variables), writers, project.Variables)
+	}
+	if _, err := os.Stat(lockFilePath(BaseFilePath(configPath))); !os.IsNotExist(err) {
+		t.Errorf("Lock file was left behind: %v", err)
+	}
+}
+
+func TestMutateProjectFailureKeepsFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, configPath string) func()
+		mutate func(project *Project) error
+	}{
+		{
+			name: "mutate fails",
+			mutate: func(project *Project) error {
+				project.Description = "changed"
+				return fmt.Errorf("no thanks")
+			},
+		},
+		{
+			name: "file is locked",
+			setup: func(t *testing.T, configPath string) func() {
+				lockTimeout := LockTimeout
+				LockTimeout = 100 * time.Millisecond
+				writeTestFile(t, lockFilePath(BaseFilePath(configPath)), "1\n")
+				return func() { LockTimeout = lockTimeout }
+			},
+		},
+		{
+			name: "directory is read only",
+			setup: func(t *testing.T, configPath string) func() {
+				if os.Geteuid() == 0 {
+					t.Skip("Permissions don't apply to root")
+				}
+				os.Chmod(configPath, 0555)
+				return func() { os.Chmod(configPath, 0755) }
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configPath, cleanup := newTestConfigDirectory(t, testBaseConfig)
+			defer cleanup()
+			if test.setup != nil {
+				defer test.setup(t, configPath)()
+			}
+
+			mutate := test.mutate
+			if mutate == nil {
+				mutate = func(project *Project) error {
+					project.Description = "changed"
+					return nil
+				}
+			}
+
+			err := MutateProject(configPath, mutate)
+			if err == nil {
+				t.Fatal("Expected changing the config to fail")
+			}
+
+			if got := readTestFile(t, BaseFilePath(configPath)); got != testBaseConfig {
+				t.Errorf("Config was changed to\n%s", got)
+			}
+
+			files, err := ioutil.ReadDir(configPath)
+			if err != nil {
+				t.Fatalf("Error reading config directory: %v", err)
+			}
+			for _, file := range files {
+				if strings.HasPrefix(file.Name(), ".tmp-") {
+					t.Errorf("Temporary file %s was left behind", path.Join(configPath, file.Name()))
+				}
+			}
+		})
+	}
+}
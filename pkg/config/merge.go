@@ -0,0 +1,224 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v3"
+)
+
+// environmentOnlyKeys are the keys of an environment config file that describe the
+// file itself rather than override the base config.
+var environmentOnlyKeys = []string{"name", "environment"}
+
+// readYAMLDocument reads the root node of a YAML file.
+func readYAMLDocument(filePath string) (*yaml.Node, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	var document yaml.Node
+	err = yaml.Unmarshal(contents, &document)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	// An empty file has no root node.
+	if len(document.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("Error reading config file %s: config must be a mapping", filePath)
+	}
+
+	return root, nil
+}
+
+// mappingIndex returns the index of the value for a key in a mapping, or -1.
+func mappingIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+// mergeRoutes merges the routes of an overlay into the base routes. Routes with the
+// same name are deep merged and new routes are added after the base routes.
+func mergeRoutes(base, overlay *yaml.Node) *yaml.Node {
+	if base.Kind != yaml.SequenceNode || overlay.Kind != yaml.SequenceNode {
+		return overlay
+	}
+
+	merged := *base
+	merged.Content = append([]*yaml.Node{}, base.Content...)
+	for _, route := range overlay.Content {
+		name := itemName(route)
+
+		found := false
+		for i, baseRoute := range merged.Content {
+			if name != "" && itemName(baseRoute) == name {
+				merged.Content[i] = mergeYAMLNodes(baseRoute, route)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			merged.Content = append(merged.Content, route)
+		}
+	}
+
+	return &merged
+}
+
+// mergeYAMLNodes deep merges an overlay onto a base node. Mappings are merged key by
+// key keeping the order of the base, the routes list is merged by route name, and
+// anything else in the overlay replaces the base value.
+func mergeYAMLNodes(base, overlay *yaml.Node) *yaml.Node {
+	if base.Kind != yaml.MappingNode || overlay.Kind != yaml.MappingNode {
+		return overlay
+	}
+
+	merged := *base
+	merged.Content = append([]*yaml.Node{}, base.Content...)
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+
+		index := mappingIndex(&merged, key.Value)
+		switch {
+		case index == -1:
+			merged.Content = append(merged.Content, key, value)
+		case key.Value == "routes":
+			merged.Content[index] = mergeRoutes(merged.Content[index], value)
+		default:
+			merged.Content[index] = mergeYAMLNodes(merged.Content[index], value)
+		}
+	}
+
+	return &merged
+}
+
+// removeDisabledRoutes drops the routes that have enabled set to false.
+func removeDisabledRoutes(config *yaml.Node) {
+	index := mappingIndex(config, "routes")
+	if index == -1 || config.Content[index].Kind != yaml.SequenceNode {
+		return
+	}
+
+	routes := config.Content[index]
+	var enabledRoutes []*yaml.Node
+	for _, route := range routes.Content {
+		if route.Kind == yaml.MappingNode {
+			enabledIndex := mappingIndex(route, "enabled")
+			enabled := true
+			if enabledIndex != -1 && route.Content[enabledIndex].Decode(&enabled) == nil && !enabled {
+				continue
+			}
+		}
+		enabledRoutes = append(enabledRoutes, route)
+	}
+	routes.Content = enabledRoutes
+}
+
+// MergedConfig is the effective config of an environment. It keeps track of the
+// nodes that came from the environment file so problems can be reported against
+// the file they are in.
+type MergedConfig struct {
+	Root                *yaml.Node
+	BaseFilePath        string
+	EnvironmentFilePath string
+
+	environmentNodes map[*yaml.Node]bool
+}
+
+// FilePath returns the path of the config file a node of the effective config was
+// read from.
+func (c *MergedConfig) FilePath(node *yaml.Node) string {
+	if c.environmentNodes[node] {
+		return c.EnvironmentFilePath
+	}
+
+	return c.BaseFilePath
+}
+
+// addNodes adds a node and every node inside it to a set.
+func addNodes(nodes map[*yaml.Node]bool, node *yaml.Node) {
+	nodes[node] = true
+	for _, child := range node.Content {
+		addNodes(nodes, child)
+	}
+}
+
+// MergeEnvironmentConfig merges an environment config file onto the base config file
+// and returns the effective config. Every key in the environment file other than
+// its name and environment overrides the base config. Routes can be turned off by
+// setting enabled to false, so a route can be defined in the base config and only
+// enabled in some environments.
+func MergeEnvironmentConfig(baseFilePath, environmentFilePath string) (*yaml.Node, error) {
+	merged, err := MergeEnvironmentConfigFiles(baseFilePath, environmentFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return merged.Root, nil
+}
+
+// MergeEnvironmentConfigFiles is MergeEnvironmentConfig that also keeps track of
+// which file each part of the effective config came from.
+func MergeEnvironmentConfigFiles(baseFilePath, environmentFilePath string) (*MergedConfig, error) {
+	base, err := readYAMLDocument(baseFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &MergedConfig{
+		Root:                base,
+		BaseFilePath:        baseFilePath,
+		EnvironmentFilePath: environmentFilePath,
+		environmentNodes:    make(map[*yaml.Node]bool),
+	}
+	if environmentFilePath != "" {
+		environment, err := readYAMLDocument(environmentFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		addNodes(merged.environmentNodes, environment)
+		merged.Root = mergeEnvironment(base, environment)
+	}
+
+	removeDisabledRoutes(merged.Root)
+	return merged, nil
+}
+
+// isEnvironmentOnlyKey checks if a key only describes the environment file.
+func isEnvironmentOnlyKey(key string) bool {
+	for _, environmentOnlyKey := range environmentOnlyKeys {
+		if key == environmentOnlyKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeEnvironment merges the root node of an environment config file onto the root
+// node of the base config.
+func mergeEnvironment(base, environment *yaml.Node) *yaml.Node {
+	// Leave out the keys that only describe the environment file.
+	overlay := *environment
+	overlay.Content = nil
+	for i := 0; i+1 < len(environment.Content); i += 2 {
+		if !isEnvironmentOnlyKey(environment.Content[i].Value) {
+			overlay.Content = append(overlay.Content, environment.Content[i], environment.Content[i+1])
+		}
+	}
+
+	return mergeYAMLNodes(base, &overlay)
+}
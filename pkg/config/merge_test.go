@@ -0,0 +1,154 @@
+package config
+
+import (
+	"path"
+	"testing"
+)
+
+func TestMergeEnvironmentConfig(t *testing.T) {
+	const base = `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes:
+  - name: users
+    route: /users
+    corsenabled: false
+  - name: admin
+    route: /admin
+    enabled: false
+`
+
+	tests := []struct {
+		name        string
+		environment string
+		want        string
+	}{
+		{
+			name:        "no overrides",
+			environment: "name: demo-app\nenvironment: dev\n",
+			want: `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes:
+  - name: users
+    route: /users
+    corsenabled: false
+`,
+		},
+		{
+			name:        "mappings are merged by key",
+			environment: "aws:\n  region: eu-west-1\nvariables:\n  STAGE: dev\n  DEBUG: \"true\"\n",
+			want: `name: Demo App
+aws:
+  region: eu-west-1
+  profile: default
+variables:
+  STAGE: dev
+  LOG_LEVEL: info
+  DEBUG: "true"
+routes:
+  - name: users
+    route: /users
+    corsenabled: false
+`,
+		},
+		{
+			name:        "routes are merged by name",
+			environment: "routes:\n  - name: users\n    corsenabled: true\n  - name: posts\n    route: /posts\n",
+			want: `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes:
+  - name: users
+    route: /users
+    corsenabled: true
+  - name: posts
+    route: /posts
+`,
+		},
+		{
+			name:        "routes can be turned on",
+			environment: "routes:\n  - name: admin\n    enabled: true\n",
+			want: `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes:
+  - name: users
+    route: /users
+    corsenabled: false
+  - name: admin
+    route: /admin
+    enabled: true
+`,
+		},
+		{
+			name:        "routes can be turned off",
+			environment: "routes:\n  - name: users\n    enabled: false\n",
+			want: `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes: []
+`,
+		},
+		{
+			name:        "environment only keys are left out",
+			environment: "name: demo-app\nenvironment: dev\ndescription: Development\n",
+			want: `name: Demo App
+aws:
+  region: us-east-1
+  profile: default
+variables:
+  STAGE: base
+  LOG_LEVEL: info
+routes:
+  - name: users
+    route: /users
+    corsenabled: false
+description: Development
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configPath, cleanup := newTestConfigDirectory(t, base)
+			defer cleanup()
+
+			environmentFilePath := path.Join(configPath, "dev.yaml")
+			writeTestFile(t, environmentFilePath, test.environment)
+
+			merged, err := MergeEnvironmentConfig(BaseFilePath(configPath), environmentFilePath)
+			if err != nil {
+				t.Fatalf("Error merging config: %v", err)
+			}
+
+			got, err := encodeYAMLDocument(merged)
+			if err != nil {
+				t.Fatalf("Error encoding merged config: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("Merged config is\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigMigration upgrades a config to a version.
+type ConfigMigration struct {
+	// Version is the version the migration upgrades the config to.
+	Version int
+
+	// Description says what the migration changes.
+	Description string
+
+	// Migrate changes the root mapping of the config. The version key is set after
+	// it runs.
+	Migrate func(config *yaml.Node) error
+}
+
+// configMigrations are the migrations in the order they run. Add a migration here
+// whenever the shape of the config changes. Configs written before the version key
+// existed are version 0.
+var configMigrations = []ConfigMigration{
+	{
+		Version:     1,
+		Description: "Add the version key",
+		Migrate:     func(config *yaml.Node) error { return nil },
+	},
+}
+
+// CurrentConfigVersion is the config version this version of Stevie writes.
+var CurrentConfigVersion = configMigrations[len(configMigrations)-1].Version
+
+// configVersion reads the version of a config. A config without a version is 0.
+func configVersion(config *yaml.Node) (int, error) {
+	index := mappingIndex(config, "version")
+	if index == -1 {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(config.Content[index].Value)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("version must be a whole number but is %q", config.Content[index].Value)
+	}
+
+	return version, nil
+}
+
+// setConfigVersion sets the version key of a config, adding it as the first key if
+// it isn't there.
+func setConfigVersion(config *yaml.Node, version int) {
+	value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(version)}
+
+	index := mappingIndex(config, "version")
+	if index != -1 {
+		config.Content[index] = value
+		return
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "version"}
+	config.Content = append([]*yaml.Node{key, value}, config.Content...)
+}
+
+// ReadConfigVersion reads the version of a config file.
+func ReadConfigVersion(filePath string) (int, error) {
+	config, err := readYAMLDocument(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	version, err := configVersion(config)
+	if err != nil {
+		return 0, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	return version, nil
+}
+
+// CheckConfigVersion returns an error if a config file is newer than this version of
+// Stevie understands.
+func CheckConfigVersion(filePath string) error {
+	version, err := ReadConfigVersion(filePath)
+	if err != nil {
+		return err
+	}
+
+	if version > CurrentConfigVersion {
+		return fmt.Errorf(
+			"%s is config version %d but this version of stevie only understands up to version %d. Please upgrade stevie",
+			filePath, version, CurrentConfigVersion,
+		)
+	}
+
+	return nil
+}
+
+// ConfigMigrationResult is the outcome of migrating a config file.
+type ConfigMigrationResult struct {
+	// OldContents is the config before the migrations, formatted the same way as
+	// the new contents so the two only differ by what the migrations changed.
+	OldContents string
+	NewContents string
+	Applied     []ConfigMigration
+
+	// Reformatted is set if writing the new contents also changes the formatting
+	// of the file.
+	Reformatted bool
+}
+
+// encodeYAMLDocument writes out a YAML document with the indentation of the config
+// files.
+func encodeYAMLDocument(document *yaml.Node) (string, error) {
+	var contents bytes.Buffer
+	encoder := yaml.NewEncoder(&contents)
+	encoder.SetIndent(2)
+	err := encoder.Encode(document)
+	if err != nil {
+		return "", err
+	}
+
+	return contents.String(), nil
+}
+
+// MigrateConfigFile runs the migrations a config file needs to reach the current
+// version. The file isn't changed, the migrated contents are returned to be written.
+func MigrateConfigFile(filePath string) (ConfigMigrationResult, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return ConfigMigrationResult{}, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+	result := ConfigMigrationResult{OldContents: string(contents), NewContents: string(contents)}
+
+	var document yaml.Node
+	err = yaml.Unmarshal(contents, &document)
+	if err != nil {
+		return result, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return result, fmt.Errorf("Error reading config file %s: config must be a mapping", filePath)
+	}
+	config := document.Content[0]
+
+	version, err := configVersion(config)
+	if err != nil {
+		return result, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+	if version > CurrentConfigVersion {
+		return result, CheckConfigVersion(filePath)
+	}
+
+	formattedContents, err := encodeYAMLDocument(&document)
+	if err != nil {
+		return result, fmt.Errorf("Error reading config file %s: %v", filePath, err)
+	}
+
+	// Run each migration newer than the config in order.
+	for _, migration := range configMigrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		err = migration.Migrate(config)
+		if err != nil {
+			return result, fmt.Errorf("Error migrating config to version %d: %v", migration.Version, err)
+		}
+		setConfigVersion(config, migration.Version)
+		result.Applied = append(result.Applied, migration)
+	}
+
+	if len(result.Applied) == 0 {
+		return result, nil
+	}
+
+	result.NewContents, err = encodeYAMLDocument(&document)
+	if err != nil {
+		return result, fmt.Errorf("Error writing migrated config: %v", err)
+	}
+	result.OldContents = formattedContents
+	result.Reformatted = formattedContents != string(contents)
+
+	return result, nil
+}
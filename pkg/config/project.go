@@ -0,0 +1,415 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/zchase/stevie/pkg/auto_pulumi"
+	"github.com/zchase/stevie/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// BaseConfigName is the name of the base config file.
+var BaseConfigName = "base"
+
+// Project is the base config of a project.
+type Project struct {
+	Version      int `yaml:",omitempty"`
+	Name         string
+	DashCaseName string
+	Description  string
+	AWS          auto_pulumi.AWSSettings      `yaml:"aws,omitempty"`
+	Defaults     ProjectDefaults              `yaml:",omitempty"`
+	Functions    auto_pulumi.FunctionSettings `yaml:",omitempty"`
+	Variables    map[string]string            `yaml:",omitempty"`
+	Routes       []auto_pulumi.APIRoute
+}
+
+// ProjectDefaults are used when a new controller is added without the option set.
+type ProjectDefaults struct {
+	// Language is the language of new controllers.
+	Language string `yaml:",omitempty"`
+
+	// CorsEnabled turns on CORS for new routes.
+	CorsEnabled bool `yaml:",omitempty"`
+}
+
+// Environment is the config file of an environment. Any other keys in the file
+// override the base config, see MergeEnvironmentConfig.
+type Environment struct {
+	Name        string
+	Environment string
+	Variables   map[string]string `yaml:",omitempty"`
+}
+
+// BaseFilePath returns the path to the base config file.
+func BaseFilePath(configPath string) string {
+	return path.Join(configPath, fmt.Sprintf("%s.yaml", BaseConfigName))
+}
+
+// EnvironmentFilePath returns the path to the config file for an environment.
+func EnvironmentFilePath(configPath, env string) string {
+	return path.Join(configPath, fmt.Sprintf("%s.yaml", env))
+}
+
+// loadFile reads a config file into a value.
+func loadFile(filePath string, value interface{}) error {
+	exists, err := utils.DoesFileExist(filePath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("Config file %s does not exist", filePath)
+	}
+
+	document, err := LoadDocument(filePath)
+	if err != nil {
+		return err
+	}
+
+	return document.Decode(value)
+}
+
+// LoadProject reads the base config.
+func LoadProject(configPath string) (Project, error) {
+	var project Project
+	err := loadFile(BaseFilePath(configPath), &project)
+	return project, err
+}
+
+// MutateProject changes the base config. The changes made by the mutate function
+// are written back to the file, keeping its comments and key order.
+func MutateProject(configPath string, mutate func(project *Project) error) error {
+	var project Project
+	return mutateFile(BaseFilePath(configPath), &project, func() error {
+		return mutate(&project)
+	})
+}
+
+// SaveProject writes the base config, creating the file if it doesn't exist.
+func SaveProject(configPath string, project Project) error {
+	return MutateProject(configPath, func(current *Project) error {
+		*current = project
+		return nil
+	})
+}
+
+// AddRoute adds a route to the base config.
+func AddRoute(configPath string, route auto_pulumi.APIRoute) error {
+	return MutateProject(configPath, func(project *Project) error {
+		for _, existingRoute := range project.Routes {
+			if existingRoute.Name == route.Name {
+				return fmt.Errorf("Route %s already exists", route.Name)
+			}
+			if existingRoute.Route == route.Route {
+				return fmt.Errorf("Route %s already serves %s", existingRoute.Name, route.Route)
+			}
+		}
+
+		project.Routes = append(project.Routes, route)
+		return nil
+	})
+}
+
+// RemoveRoute removes a route from the base config and its overrides from the
+// environment config files.
+func RemoveRoute(configPath string, name string) error {
+	err := MutateProject(configPath, func(project *Project) error {
+		for i, route := range project.Routes {
+			if route.Name == name {
+				project.Routes = append(project.Routes[:i], project.Routes[i+1:]...)
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Route %s does not exist", name)
+	})
+	if err != nil {
+		return err
+	}
+
+	return removeEnvironmentRouteOverrides(configPath, name, "")
+}
+
+// RemoveRouteMethod removes the settings of a method of a route from the base config
+// and the environment config files.
+func RemoveRouteMethod(configPath string, name, method string) error {
+	err := MutateProject(configPath, func(project *Project) error {
+		for i, route := range project.Routes {
+			if route.Name != name {
+				continue
+			}
+
+			for methodName := range route.Methods {
+				if strings.EqualFold(methodName, method) {
+					delete(project.Routes[i].Methods, methodName)
+				}
+			}
+			if len(project.Routes[i].Methods) == 0 {
+				project.Routes[i].Methods = nil
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Route %s does not exist", name)
+	})
+	if err != nil {
+		return err
+	}
+
+	return removeEnvironmentRouteOverrides(configPath, name, method)
+}
+
+// removeEnvironmentRouteOverrides removes the overrides of a route, or of one of its
+// methods if method is set, from every environment config file.
+func removeEnvironmentRouteOverrides(configPath, name, method string) error {
+	environments, err := ListEnvironments(configPath)
+	if err != nil {
+		return err
+	}
+
+	for _, env := range environments {
+		err = removeRouteOverride(EnvironmentFilePath(configPath, env), name, method)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeRouteOverride removes the override of a route, or of one of its methods,
+// from an environment config file. The file is only written if it changes.
+func removeRouteOverride(filePath, name, method string) error {
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	document, err := LoadDocument(filePath)
+	if err != nil {
+		return err
+	}
+
+	root := document.root()
+	if root == nil || root.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	routesIndex := mappingIndex(root, "routes")
+	if routesIndex == -1 || root.Content[routesIndex].Kind != yaml.SequenceNode {
+		return nil
+	}
+	routes := root.Content[routesIndex]
+
+	for i, route := range routes.Content {
+		if itemName(route) != name {
+			continue
+		}
+
+		if method == "" {
+			routes.Content = append(routes.Content[:i], routes.Content[i+1:]...)
+		} else {
+			methods := lookupNode(route, []string{"methods"})
+			if methods == nil || methods.Kind != yaml.MappingNode {
+				return nil
+			}
+
+			// Methods are names rather than config keys, so like RemoveRouteMethod
+			// they are matched without case.
+			var remaining []*yaml.Node
+			for j := 0; j+1 < len(methods.Content); j += 2 {
+				if !strings.EqualFold(methods.Content[j].Value, method) {
+					remaining = append(remaining, methods.Content[j], methods.Content[j+1])
+				}
+			}
+			if len(remaining) == len(methods.Content) {
+				return nil
+			}
+			methods.Content = remaining
+		}
+
+		// Drop the routes key once it has no routes left.
+		if len(routes.Content) == 0 {
+			root.Content = append(root.Content[:routesIndex-1], root.Content[routesIndex+1:]...)
+		}
+
+		return document.Save()
+	}
+
+	return nil
+}
+
+// LoadEnvironment reads the config file of an environment.
+func LoadEnvironment(configPath, env string) (Environment, error) {
+	var environment Environment
+	err := loadFile(EnvironmentFilePath(configPath, env), &environment)
+	return environment, err
+}
+
+// MutateEnvironment changes the config file of an environment.
+func MutateEnvironment(configPath, env string, mutate func(environment *Environment) error) error {
+	var environment Environment
+	return mutateFile(EnvironmentFilePath(configPath, env), &environment, func() error {
+		return mutate(&environment)
+	})
+}
+
+// SaveEnvironment writes the config file of an environment, creating it if it
+// doesn't exist.
+func SaveEnvironment(configPath string, environment Environment) error {
+	return MutateEnvironment(configPath, environment.Environment, func(current *Environment) error {
+		*current = environment
+		return nil
+	})
+}
+
+// environmentNamePattern matches the names that can be used for an environment. The
+// name is also used for the Pulumi stack.
+var environmentNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// ValidateEnvironmentName checks a name can be used for a new environment.
+func ValidateEnvironmentName(env string) error {
+	if !environmentNamePattern.MatchString(env) {
+		return fmt.Errorf("Environment name %q can only contain letters, numbers, dashes, underscores, and dots", env)
+	}
+	if env == BaseConfigName {
+		return fmt.Errorf("Environment name %s is used by the base config", env)
+	}
+
+	return nil
+}
+
+// ListEnvironments returns the sorted names of the environments that have a config
+// file.
+func ListEnvironments(configPath string) ([]string, error) {
+	files, err := ioutil.ReadDir(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config directory %s: %v", configPath, err)
+	}
+
+	var environments []string
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || strings.HasPrefix(name, ".") || path.Ext(name) != ".yaml" {
+			continue
+		}
+
+		env := strings.TrimSuffix(name, ".yaml")
+		if env != BaseConfigName {
+			environments = append(environments, env)
+		}
+	}
+	sort.Strings(environments)
+
+	return environments, nil
+}
+
+// CopyEnvironment creates the config file of an environment from the config file of
+// another one. Comments and overrides are kept.
+func CopyEnvironment(configPath, from, to string) error {
+	targetPath := EnvironmentFilePath(configPath, to)
+	unlock, err := lockFile(targetPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	exists, err := utils.DoesFileExist(targetPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("Config file %s already exists", targetPath)
+	}
+
+	var source Environment
+	err = loadFile(EnvironmentFilePath(configPath, from), &source)
+	if err != nil {
+		return err
+	}
+
+	document, err := LoadDocument(EnvironmentFilePath(configPath, from))
+	if err != nil {
+		return err
+	}
+	document.Path = targetPath
+
+	target := source
+	target.Environment = to
+	err = document.Update(source, target)
+	if err != nil {
+		return err
+	}
+
+	return document.Save()
+}
+
+// RemoveEnvironment deletes the config file of an environment.
+func RemoveEnvironment(configPath, env string) error {
+	filePath := EnvironmentFilePath(configPath, env)
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = os.Remove(filePath)
+	if err != nil {
+		return fmt.Errorf("Error removing config file %s: %v", filePath, err)
+	}
+
+	return nil
+}
+
+// LoadMerged reads the base config with the config for an environment merged on top
+// of it. The environment file is optional and if env is empty the base config is
+// returned.
+func LoadMerged(configPath, env string) (*yaml.Node, error) {
+	merged, err := LoadMergedConfig(configPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return merged.Root, nil
+}
+
+// LoadMergedConfig is LoadMerged that also keeps track of which file each part of
+// the effective config came from.
+func LoadMergedConfig(configPath, env string) (*MergedConfig, error) {
+	environmentFilePath := ""
+	if env != "" {
+		environmentFilePath = EnvironmentFilePath(configPath, env)
+		exists, err := utils.DoesFileExist(environmentFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			environmentFilePath = ""
+		}
+	}
+
+	return MergeEnvironmentConfigFiles(BaseFilePath(configPath), environmentFilePath)
+}
+
+// LoadProjectForEnvironment reads the effective config for an environment.
+func LoadProjectForEnvironment(configPath, env string) (Project, error) {
+	merged, err := LoadMerged(configPath, env)
+	if err != nil {
+		return Project{}, err
+	}
+
+	var project Project
+	err = merged.Decode(&project)
+	if err != nil {
+		return Project{}, fmt.Errorf("Error reading config for environment %s: %v", env, err)
+	}
+
+	return project, nil
+}
@@ -0,0 +1,215 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zchase/stevie/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// SplitKey splits a dotted config key, like routes.users.corsenabled, into its parts.
+func SplitKey(key string) ([]string, error) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("Config key %q has an empty part", key)
+		}
+	}
+
+	return parts, nil
+}
+
+// sequenceIndex returns the index of a list item by its name or position, or -1.
+func sequenceIndex(sequence *yaml.Node, part string) int {
+	for i, item := range sequence.Content {
+		if itemName(item) == part {
+			return i
+		}
+	}
+
+	if index, err := strconv.Atoi(part); err == nil && index >= 0 && index < len(sequence.Content) {
+		return index
+	}
+
+	return -1
+}
+
+// lookupNode finds the node for the parts of a key. Mapping keys are compared exactly
+// and list items are found by name or position.
+func lookupNode(node *yaml.Node, parts []string) *yaml.Node {
+	for _, part := range parts {
+		switch node.Kind {
+		case yaml.MappingNode:
+			index := mappingIndex(node, part)
+			if index == -1 {
+				return nil
+			}
+			node = node.Content[index]
+		case yaml.SequenceNode:
+			index := sequenceIndex(node, part)
+			if index == -1 {
+				return nil
+			}
+			node = node.Content[index]
+		default:
+			return nil
+		}
+	}
+
+	return node
+}
+
+// ParseValue parses a value given on the command line as YAML so numbers and
+// booleans keep their type.
+func ParseValue(value string) (*yaml.Node, error) {
+	var document yaml.Node
+	err := yaml.Unmarshal([]byte(value), &document)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing value %q: %v", value, err)
+	}
+
+	if len(document.Content) == 0 {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}, nil
+	}
+
+	return document.Content[0], nil
+}
+
+// setNode sets the value for the parts of a key, creating the mappings along the way.
+// A route that isn't in the list yet is added with just its name so an environment
+// can override part of a route from the base config.
+func setNode(node *yaml.Node, parts []string, value *yaml.Node) error {
+	part := parts[0]
+	last := len(parts) == 1
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		index := mappingIndex(node, part)
+		if last {
+			if index == -1 {
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part}, value)
+			} else {
+				node.Content[index] = keepComments(node.Content[index], value)
+			}
+			return nil
+		}
+
+		if index == -1 {
+			child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			if part == "routes" {
+				child = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: part}, child)
+			index = len(node.Content) - 1
+		}
+		return setNode(node.Content[index], parts[1:], value)
+	case yaml.SequenceNode:
+		if last {
+			return fmt.Errorf("%s is a list item, set one of its keys instead", part)
+		}
+
+		index := sequenceIndex(node, part)
+		if index == -1 {
+			item := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: part},
+			}}
+			node.Content = append(node.Content, item)
+			index = len(node.Content) - 1
+		}
+		return setNode(node.Content[index], parts[1:], value)
+	default:
+		return fmt.Errorf("Can't set %s, its parent is not a mapping", part)
+	}
+}
+
+// GetValue returns the value of a dotted key in the effective config of an
+// environment, or the base config if env is empty.
+func GetValue(configPath, env, key string) (*yaml.Node, error) {
+	parts, err := SplitKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := LoadMerged(configPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	node := lookupNode(merged, parts)
+	if node == nil {
+		return nil, fmt.Errorf("Config key %s is not set", key)
+	}
+
+	return node, nil
+}
+
+// SetValue sets a dotted key in the base config, or in the config file of an
+// environment if env is set. The check function gets the effective config with the
+// change made and can stop the file from being written by returning an error.
+func SetValue(configPath, env, key string, value *yaml.Node, check func(effective *yaml.Node) error) error {
+	parts, err := SplitKey(key)
+	if err != nil {
+		return err
+	}
+
+	filePath := BaseFilePath(configPath)
+	if env != "" {
+		filePath = EnvironmentFilePath(configPath, env)
+		if isEnvironmentOnlyKey(parts[0]) {
+			return fmt.Errorf("%s describes the environment file and can't be set", parts[0])
+		}
+	}
+
+	unlock, err := lockFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	exists, err := utils.DoesFileExist(filePath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("Config file %s does not exist", filePath)
+	}
+
+	document, err := LoadDocument(filePath)
+	if err != nil {
+		return err
+	}
+
+	root := document.root()
+	if root == nil {
+		root = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		document.document.Content = []*yaml.Node{root}
+	}
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("Config file %s must be a mapping", filePath)
+	}
+
+	err = setNode(root, parts, value)
+	if err != nil {
+		return err
+	}
+
+	// Work out the effective config with the change made.
+	effective := root
+	if env != "" {
+		base, err := readYAMLDocument(BaseFilePath(configPath))
+		if err != nil {
+			return err
+		}
+		effective = mergeEnvironment(base, root)
+	}
+
+	err = check(effective)
+	if err != nil {
+		return err
+	}
+
+	return document.Save()
+}
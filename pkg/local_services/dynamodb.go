@@ -0,0 +1,937 @@
+package local_services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+const (
+	// dynamoDBTargetPrefix is the prefix of the X-Amz-Target header on DynamoDB requests.
+	dynamoDBTargetPrefix = "DynamoDB_20120810."
+
+	// dynamoDBErrorPrefix is the prefix of the error types DynamoDB returns.
+	dynamoDBErrorPrefix = "com.amazonaws.dynamodb.v20120810#"
+)
+
+// dynamoDBError is an error returned to the client in the DynamoDB format.
+type dynamoDBError struct {
+	Type    string
+	Message string
+}
+
+func (e *dynamoDBError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// newValidationError creates a ValidationException.
+func newValidationError(format string, args ...interface{}) *dynamoDBError {
+	return &dynamoDBError{Type: "ValidationException", Message: fmt.Sprintf(format, args...)}
+}
+
+// newResourceNotFoundError creates a ResourceNotFoundException.
+func newResourceNotFoundError() *dynamoDBError {
+	return &dynamoDBError{Type: "ResourceNotFoundException", Message: "Requested resource not found"}
+}
+
+// newConditionalCheckFailedError creates a ConditionalCheckFailedException.
+func newConditionalCheckFailedError() *dynamoDBError {
+	return &dynamoDBError{Type: "ConditionalCheckFailedException", Message: "The conditional request failed"}
+}
+
+type keySchemaElement struct {
+	AttributeName string
+	KeyType       string
+}
+
+type attributeDefinition struct {
+	AttributeName string
+	AttributeType string
+}
+
+type secondaryIndex struct {
+	IndexName  string
+	KeySchema  []keySchemaElement
+	Projection map[string]interface{} `json:",omitempty"`
+}
+
+// dynamoTable is a table and its items, keyed by their encoded primary key.
+type dynamoTable struct {
+	TableName              string
+	KeySchema              []keySchemaElement
+	AttributeDefinitions   []attributeDefinition
+	GlobalSecondaryIndexes []secondaryIndex `json:",omitempty"`
+	LocalSecondaryIndexes  []secondaryIndex `json:",omitempty"`
+	CreationDateTime       float64
+	Items                  map[string]dynamoItem
+}
+
+// keyAttributeNames returns the partition and sort key names of a key schema.
+func keyAttributeNames(keySchema []keySchemaElement) (string, string) {
+	var partitionKey, sortKey string
+	for _, element := range keySchema {
+		if element.KeyType == "HASH" {
+			partitionKey = element.AttributeName
+		} else if element.KeyType == "RANGE" {
+			sortKey = element.AttributeName
+		}
+	}
+
+	return partitionKey, sortKey
+}
+
+// attributeType returns the type of a key attribute from the attribute definitions.
+func (t *dynamoTable) attributeType(name string) string {
+	for _, definition := range t.AttributeDefinitions {
+		if definition.AttributeName == name {
+			return definition.AttributeType
+		}
+	}
+
+	return ""
+}
+
+// encodeKey encodes the primary key of an item. It returns an error if the item is
+// missing a key attribute or has the wrong type for one.
+func (t *dynamoTable) encodeKey(item dynamoItem) (string, error) {
+	var parts []string
+	for _, element := range t.KeySchema {
+		value, ok := item[element.AttributeName]
+		if !ok {
+			return "", newValidationError("One of the required keys was not given a value")
+		}
+
+		expectedType := t.attributeType(element.AttributeName)
+		keyValue, isString := value[expectedType].(string)
+		if len(value) != 1 || !isString {
+			return "", newValidationError("One or more parameter values were invalid: Type mismatch for key %s expected: %s", element.AttributeName, expectedType)
+		}
+		parts = append(parts, fmt.Sprintf("%s:%s", expectedType, keyValue))
+	}
+
+	return strings.Join(parts, "\x00"), nil
+}
+
+// keyOf returns just the primary key attributes of an item.
+func keyOf(item dynamoItem, keySchema []keySchemaElement) dynamoItem {
+	key := make(dynamoItem)
+	for _, element := range keySchema {
+		if value, ok := item[element.AttributeName]; ok {
+			key[element.AttributeName] = value
+		}
+	}
+
+	return key
+}
+
+// description returns the table description DynamoDB returns for a table in a region.
+func (t *dynamoTable) description(region string) map[string]interface{} {
+	description := map[string]interface{}{
+		"TableName":            t.TableName,
+		"TableArn":             fmt.Sprintf("arn:aws:dynamodb:%s:%s:table/%s", region, AccountID, t.TableName),
+		"TableStatus":          "ACTIVE",
+		"KeySchema":            t.KeySchema,
+		"AttributeDefinitions": t.AttributeDefinitions,
+		"CreationDateTime":     t.CreationDateTime,
+		"ItemCount":            len(t.Items),
+		"TableSizeBytes":       0,
+		"BillingModeSummary":   map[string]string{"BillingMode": "PAY_PER_REQUEST"},
+	}
+
+	indexDescriptions := func(indexes []secondaryIndex) []map[string]interface{} {
+		var descriptions []map[string]interface{}
+		for _, index := range indexes {
+			descriptions = append(descriptions, map[string]interface{}{
+				"IndexName":   index.IndexName,
+				"IndexArn":    fmt.Sprintf("arn:aws:dynamodb:%s:%s:table/%s/index/%s", region, AccountID, t.TableName, index.IndexName),
+				"KeySchema":   index.KeySchema,
+				"Projection":  index.Projection,
+				"IndexStatus": "ACTIVE",
+				"ItemCount":   len(t.Items),
+			})
+		}
+		return descriptions
+	}
+
+	if len(t.GlobalSecondaryIndexes) > 0 {
+		description["GlobalSecondaryIndexes"] = indexDescriptions(t.GlobalSecondaryIndexes)
+	}
+	if len(t.LocalSecondaryIndexes) > 0 {
+		description["LocalSecondaryIndexes"] = indexDescriptions(t.LocalSecondaryIndexes)
+	}
+
+	return description
+}
+
+// dynamoDBService is a file backed stand-in for DynamoDB. Each table is stored as a
+// JSON file in the data directory.
+type dynamoDBService struct {
+	DataDirectory string
+	Region        string
+
+	mutex  sync.Mutex
+	tables map[string]*dynamoTable
+}
+
+// newDynamoDBService loads the tables in a data directory.
+func newDynamoDBService(dataDirectory, region string) (*dynamoDBService, error) {
+	err := os.MkdirAll(dataDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &dynamoDBService{
+		DataDirectory: dataDirectory,
+		Region:        region,
+		tables:        make(map[string]*dynamoTable),
+	}
+
+	tableFiles, err := filepath.Glob(path.Join(dataDirectory, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableFile := range tableFiles {
+		contents, err := ioutil.ReadFile(tableFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var table dynamoTable
+		err = json.Unmarshal(contents, &table)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading table %s: %v", tableFile, err)
+		}
+		if table.Items == nil {
+			table.Items = make(map[string]dynamoItem)
+		}
+		service.tables[table.TableName] = &table
+	}
+
+	return service, nil
+}
+
+// tablePath returns the path of the file a table is stored in.
+func (s *dynamoDBService) tablePath(tableName string) string {
+	return path.Join(s.DataDirectory, fmt.Sprintf("%s.json", tableName))
+}
+
+// saveTable writes a table out to its file.
+func (s *dynamoDBService) saveTable(table *dynamoTable) error {
+	contents, err := json.MarshalIndent(table, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.WriteFileAtomically(s.tablePath(table.TableName), contents)
+}
+
+// saveItems saves a table with a change made to its items. The table in memory is
+// only changed once it has been saved, so a failed save leaves it as it was.
+func (s *dynamoDBService) saveItems(table *dynamoTable, change func(items map[string]dynamoItem)) error {
+	items := make(map[string]dynamoItem, len(table.Items))
+	for key, item := range table.Items {
+		items[key] = item
+	}
+	change(items)
+
+	updated := *table
+	updated.Items = items
+	if err := s.saveTable(&updated); err != nil {
+		return err
+	}
+
+	table.Items = items
+	return nil
+}
+
+// getTable gets a table by name.
+func (s *dynamoDBService) getTable(tableName string) (*dynamoTable, error) {
+	table, ok := s.tables[tableName]
+	if !ok {
+		return nil, newResourceNotFoundError()
+	}
+
+	return table, nil
+}
+
+// ServeHTTP handles a DynamoDB JSON protocol request.
+func (s *dynamoDBService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	operation := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), dynamoDBTargetPrefix)
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeDynamoDBError(w, newValidationError("Error reading request: %v", err))
+		return
+	}
+
+	s.mutex.Lock()
+	response, err := s.handleOperation(operation, body)
+	s.mutex.Unlock()
+
+	if err != nil {
+		if dynamoErr, ok := err.(*dynamoDBError); ok {
+			writeDynamoDBError(w, dynamoErr)
+		} else {
+			writeDynamoDBError(w, &dynamoDBError{Type: "InternalServerError", Message: err.Error()})
+		}
+		return
+	}
+
+	responseBody, err := json.Marshal(response)
+	if err != nil {
+		writeDynamoDBError(w, &dynamoDBError{Type: "InternalServerError", Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Write(responseBody)
+}
+
+// writeDynamoDBError writes an error out in the DynamoDB format.
+func writeDynamoDBError(w http.ResponseWriter, err *dynamoDBError) {
+	statusCode := http.StatusBadRequest
+	if err.Type == "InternalServerError" {
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{
+		"__type":  dynamoDBErrorPrefix + err.Type,
+		"message": err.Message,
+	})
+}
+
+// handleOperation runs a DynamoDB operation.
+func (s *dynamoDBService) handleOperation(operation string, body []byte) (interface{}, error) {
+	var handler func(body []byte) (interface{}, error)
+	switch operation {
+	case "CreateTable":
+		handler = s.createTable
+	case "DeleteTable":
+		handler = s.deleteTable
+	case "DescribeTable":
+		handler = s.describeTable
+	case "ListTables":
+		handler = s.listTables
+	case "PutItem":
+		handler = s.putItem
+	case "GetItem":
+		handler = s.getItem
+	case "DeleteItem":
+		handler = s.deleteItem
+	case "UpdateItem":
+		handler = s.updateItem
+	case "Query":
+		handler = s.query
+	case "Scan":
+		handler = s.scan
+	case "BatchGetItem":
+		handler = s.batchGetItem
+	case "BatchWriteItem":
+		handler = s.batchWriteItem
+	default:
+		return nil, &dynamoDBError{Type: "UnknownOperationException", Message: fmt.Sprintf("The operation %s is not supported by the local DynamoDB", operation)}
+	}
+
+	return handler(body)
+}
+
+// decodeDynamoDBRequest decodes the body of a request.
+func decodeDynamoDBRequest(body []byte, request interface{}) error {
+	err := json.Unmarshal(body, request)
+	if err != nil {
+		return newValidationError("Error parsing request: %v", err)
+	}
+
+	return nil
+}
+
+type createTableRequest struct {
+	TableName              string
+	KeySchema              []keySchemaElement
+	AttributeDefinitions   []attributeDefinition
+	GlobalSecondaryIndexes []secondaryIndex
+	LocalSecondaryIndexes  []secondaryIndex
+}
+
+func (s *dynamoDBService) createTable(body []byte) (interface{}, error) {
+	var request createTableRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	if request.TableName == "" || strings.ContainsAny(request.TableName, "/\\") {
+		return nil, newValidationError("Invalid table name: %q", request.TableName)
+	}
+	if _, ok := s.tables[request.TableName]; ok {
+		return nil, &dynamoDBError{Type: "ResourceInUseException", Message: fmt.Sprintf("Table already exists: %s", request.TableName)}
+	}
+
+	table := &dynamoTable{
+		TableName:              request.TableName,
+		KeySchema:              request.KeySchema,
+		AttributeDefinitions:   request.AttributeDefinitions,
+		GlobalSecondaryIndexes: request.GlobalSecondaryIndexes,
+		LocalSecondaryIndexes:  request.LocalSecondaryIndexes,
+		CreationDateTime:       float64(time.Now().Unix()),
+		Items:                  make(map[string]dynamoItem),
+	}
+
+	// Every key attribute needs a definition.
+	partitionKey, _ := keyAttributeNames(table.KeySchema)
+	if partitionKey == "" {
+		return nil, newValidationError("One or more parameter values were invalid: Missing the key HASH in the KeySchema")
+	}
+	keySchemas := [][]keySchemaElement{table.KeySchema}
+	for _, index := range append(append([]secondaryIndex{}, table.GlobalSecondaryIndexes...), table.LocalSecondaryIndexes...) {
+		keySchemas = append(keySchemas, index.KeySchema)
+	}
+	for _, keySchema := range keySchemas {
+		for _, element := range keySchema {
+			if table.attributeType(element.AttributeName) == "" {
+				return nil, newValidationError("One or more parameter values were invalid: Some index key attributes are not defined in AttributeDefinitions")
+			}
+		}
+	}
+
+	if err := s.saveTable(table); err != nil {
+		return nil, err
+	}
+	s.tables[table.TableName] = table
+
+	return map[string]interface{}{"TableDescription": table.description(s.Region)}, nil
+}
+
+type tableRequest struct {
+	TableName string
+}
+
+func (s *dynamoDBService) deleteTable(body []byte) (interface{}, error) {
+	var request tableRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Remove(s.tablePath(table.TableName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	delete(s.tables, table.TableName)
+
+	return map[string]interface{}{"TableDescription": table.description(s.Region)}, nil
+}
+
+func (s *dynamoDBService) describeTable(body []byte) (interface{}, error) {
+	var request tableRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"Table": table.description(s.Region)}, nil
+}
+
+func (s *dynamoDBService) listTables(body []byte) (interface{}, error) {
+	tableNames := []string{}
+	for tableName := range s.tables {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	return map[string]interface{}{"TableNames": tableNames}, nil
+}
+
+// itemRequest holds the fields shared by the single item operations.
+type itemRequest struct {
+	TableName                 string
+	Item                      dynamoItem
+	Key                       dynamoItem
+	ConditionExpression       string
+	UpdateExpression          string
+	ProjectionExpression      string
+	ExpressionAttributeNames  map[string]string
+	ExpressionAttributeValues map[string]attributeValue
+	ReturnValues              string
+}
+
+// checkCondition checks the condition expression of a request against an item.
+func checkCondition(request itemRequest, item dynamoItem) error {
+	condition, err := parseConditionExpression(request.ConditionExpression, request.ExpressionAttributeNames, request.ExpressionAttributeValues)
+	if err != nil {
+		return newValidationError("Invalid ConditionExpression: %v", err)
+	}
+
+	if item == nil {
+		item = dynamoItem{}
+	}
+	if !condition(item) {
+		return newConditionalCheckFailedError()
+	}
+
+	return nil
+}
+
+// returnValues builds the response for the ReturnValues of a request.
+func returnValues(returnValue string, oldItem, newItem dynamoItem) map[string]interface{} {
+	response := make(map[string]interface{})
+	switch returnValue {
+	case "ALL_OLD", "UPDATED_OLD":
+		if oldItem != nil {
+			response["Attributes"] = oldItem
+		}
+	case "ALL_NEW", "UPDATED_NEW":
+		if newItem != nil {
+			response["Attributes"] = newItem
+		}
+	}
+
+	return response
+}
+
+func (s *dynamoDBService) putItem(body []byte) (interface{}, error) {
+	var request itemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.encodeKey(request.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	oldItem := table.Items[key]
+	if err = checkCondition(request, oldItem); err != nil {
+		return nil, err
+	}
+
+	err = s.saveItems(table, func(items map[string]dynamoItem) {
+		items[key] = request.Item
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return returnValues(request.ReturnValues, oldItem, nil), nil
+}
+
+func (s *dynamoDBService) getItem(body []byte) (interface{}, error) {
+	var request itemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.encodeKey(request.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	projection, err := parseProjectionExpression(request.ProjectionExpression, request.ExpressionAttributeNames)
+	if err != nil {
+		return nil, newValidationError("Invalid ProjectionExpression: %v", err)
+	}
+
+	response := make(map[string]interface{})
+	if item, ok := table.Items[key]; ok {
+		response["Item"] = projectItem(item, projection)
+	}
+
+	return response, nil
+}
+
+func (s *dynamoDBService) deleteItem(body []byte) (interface{}, error) {
+	var request itemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.encodeKey(request.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	oldItem := table.Items[key]
+	if err = checkCondition(request, oldItem); err != nil {
+		return nil, err
+	}
+
+	if oldItem != nil {
+		err = s.saveItems(table, func(items map[string]dynamoItem) {
+			delete(items, key)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return returnValues(request.ReturnValues, oldItem, nil), nil
+}
+
+func (s *dynamoDBService) updateItem(body []byte) (interface{}, error) {
+	var request itemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.encodeKey(request.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	oldItem := table.Items[key]
+	if err = checkCondition(request, oldItem); err != nil {
+		return nil, err
+	}
+
+	actions, err := parseUpdateExpression(request.UpdateExpression, request.ExpressionAttributeNames, request.ExpressionAttributeValues)
+	if err != nil {
+		return nil, newValidationError("%v", err)
+	}
+
+	// Updates create the item if it doesn't exist yet.
+	original := oldItem
+	if original == nil {
+		original = copyItem(request.Key)
+	}
+	updated := copyItem(original)
+	for _, action := range actions {
+		if err = action(original, updated); err != nil {
+			return nil, newValidationError("%v", err)
+		}
+	}
+
+	// The key can't be changed by an update.
+	if newKey, err := table.encodeKey(updated); err != nil || newKey != key {
+		return nil, newValidationError("One or more parameter values were invalid: Cannot update attribute that is part of the key")
+	}
+
+	err = s.saveItems(table, func(items map[string]dynamoItem) {
+		items[key] = updated
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return returnValues(request.ReturnValues, oldItem, updated), nil
+}
+
+// searchRequest holds the fields for Query and Scan.
+type searchRequest struct {
+	TableName                 string
+	IndexName                 string
+	KeyConditionExpression    string
+	FilterExpression          string
+	ProjectionExpression      string
+	ExpressionAttributeNames  map[string]string
+	ExpressionAttributeValues map[string]attributeValue
+	Limit                     int
+	ScanIndexForward          *bool
+	ExclusiveStartKey         dynamoItem
+	Select                    string
+}
+
+// indexKeySchema returns the key schema of the table or one of its indexes.
+func (t *dynamoTable) indexKeySchema(indexName string) ([]keySchemaElement, error) {
+	if indexName == "" {
+		return t.KeySchema, nil
+	}
+
+	for _, index := range append(append([]secondaryIndex{}, t.GlobalSecondaryIndexes...), t.LocalSecondaryIndexes...) {
+		if index.IndexName == indexName {
+			return index.KeySchema, nil
+		}
+	}
+
+	return nil, newValidationError("The table does not have the specified index: %s", indexName)
+}
+
+// search runs a Query or Scan. Items are ordered by the partition and sort key of
+// the table or index.
+func (s *dynamoDBService) search(request searchRequest, isQuery bool) (interface{}, error) {
+	table, err := s.getTable(request.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	keySchema, err := table.indexKeySchema(request.IndexName)
+	if err != nil {
+		return nil, err
+	}
+	partitionKey, sortKey := keyAttributeNames(keySchema)
+
+	keyCondition, err := parseConditionExpression(request.KeyConditionExpression, request.ExpressionAttributeNames, request.ExpressionAttributeValues)
+	if err != nil {
+		return nil, newValidationError("Invalid KeyConditionExpression: %v", err)
+	}
+	if isQuery && strings.TrimSpace(request.KeyConditionExpression) == "" {
+		return nil, newValidationError("Either the KeyConditions or KeyConditionExpression parameter must be specified in the request.")
+	}
+
+	filter, err := parseConditionExpression(request.FilterExpression, request.ExpressionAttributeNames, request.ExpressionAttributeValues)
+	if err != nil {
+		return nil, newValidationError("Invalid FilterExpression: %v", err)
+	}
+
+	projection, err := parseProjectionExpression(request.ProjectionExpression, request.ExpressionAttributeNames)
+	if err != nil {
+		return nil, newValidationError("Invalid ProjectionExpression: %v", err)
+	}
+
+	// Items missing the index keys aren't in the index.
+	var items []dynamoItem
+	for _, item := range table.Items {
+		if _, ok := item[partitionKey]; !ok {
+			continue
+		}
+		if _, ok := item[sortKey]; sortKey != "" && !ok {
+			continue
+		}
+		if keyCondition(item) {
+			items = append(items, item)
+		}
+	}
+
+	// compareItems orders items by the index key and then the table key, which is
+	// the order pages are read in.
+	compareItems := func(a, b dynamoItem) int {
+		for _, name := range []string{partitionKey, sortKey} {
+			if name == "" {
+				continue
+			}
+			if comparison, _ := compareAttributeValues(a[name], b[name]); comparison != 0 {
+				return comparison
+			}
+		}
+
+		// Items with the same index key are ordered by the table key.
+		tableKeyA, _ := table.encodeKey(a)
+		tableKeyB, _ := table.encodeKey(b)
+		return strings.Compare(tableKeyA, tableKeyB)
+	}
+	forward := request.ScanIndexForward == nil || *request.ScanIndexForward
+	sort.SliceStable(items, func(i, j int) bool {
+		if forward {
+			return compareItems(items[i], items[j]) < 0
+		}
+		return compareItems(items[i], items[j]) > 0
+	})
+
+	// Continue from the first item after the one the last page ended on. That item
+	// may have been deleted since, so the start key is compared with the items
+	// rather than looked up.
+	if request.ExclusiveStartKey != nil {
+		if _, err := table.encodeKey(request.ExclusiveStartKey); err != nil {
+			return nil, newValidationError("The provided starting key is invalid")
+		}
+
+		start := len(items)
+		for i, item := range items {
+			comparison := compareItems(item, request.ExclusiveStartKey)
+			if (forward && comparison > 0) || (!forward && comparison < 0) {
+				start = i
+				break
+			}
+		}
+		items = items[start:]
+	}
+
+	// The limit applies to the items read before the filter.
+	var lastEvaluatedKey dynamoItem
+	if request.Limit > 0 && len(items) > request.Limit {
+		items = items[:request.Limit]
+		lastItem := items[len(items)-1]
+		lastEvaluatedKey = keyOf(lastItem, append(append([]keySchemaElement{}, table.KeySchema...), keySchema...))
+	}
+
+	results := []dynamoItem{}
+	for _, item := range items {
+		if filter(item) {
+			results = append(results, projectItem(item, projection))
+		}
+	}
+
+	response := map[string]interface{}{
+		"Count":        len(results),
+		"ScannedCount": len(items),
+	}
+	if request.Select != "COUNT" {
+		response["Items"] = results
+	}
+	if lastEvaluatedKey != nil {
+		response["LastEvaluatedKey"] = lastEvaluatedKey
+	}
+
+	return response, nil
+}
+
+func (s *dynamoDBService) query(body []byte) (interface{}, error) {
+	var request searchRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	return s.search(request, true)
+}
+
+func (s *dynamoDBService) scan(body []byte) (interface{}, error) {
+	var request searchRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+	request.KeyConditionExpression = ""
+
+	return s.search(request, false)
+}
+
+type batchGetItemRequest struct {
+	RequestItems map[string]struct {
+		Keys                     []dynamoItem
+		ProjectionExpression     string
+		ExpressionAttributeNames map[string]string
+	}
+}
+
+func (s *dynamoDBService) batchGetItem(body []byte) (interface{}, error) {
+	var request batchGetItemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	responses := make(map[string][]dynamoItem)
+	for tableName, tableRequest := range request.RequestItems {
+		table, err := s.getTable(tableName)
+		if err != nil {
+			return nil, err
+		}
+
+		projection, err := parseProjectionExpression(tableRequest.ProjectionExpression, tableRequest.ExpressionAttributeNames)
+		if err != nil {
+			return nil, newValidationError("Invalid ProjectionExpression: %v", err)
+		}
+
+		responses[tableName] = []dynamoItem{}
+		for _, keyItem := range tableRequest.Keys {
+			key, err := table.encodeKey(keyItem)
+			if err != nil {
+				return nil, err
+			}
+			if item, ok := table.Items[key]; ok {
+				responses[tableName] = append(responses[tableName], projectItem(item, projection))
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"Responses":       responses,
+		"UnprocessedKeys": map[string]interface{}{},
+	}, nil
+}
+
+type batchWriteItemRequest struct {
+	RequestItems map[string][]struct {
+		PutRequest *struct {
+			Item dynamoItem
+		}
+		DeleteRequest *struct {
+			Key dynamoItem
+		}
+	}
+}
+
+func (s *dynamoDBService) batchWriteItem(body []byte) (interface{}, error) {
+	var request batchWriteItemRequest
+	if err := decodeDynamoDBRequest(body, &request); err != nil {
+		return nil, err
+	}
+
+	// Check every request before writing anything so an invalid request doesn't
+	// leave part of the batch written.
+	type tableWrite struct {
+		table   *dynamoTable
+		puts    map[string]dynamoItem
+		deletes []string
+	}
+	var writes []tableWrite
+	for tableName, writeRequests := range request.RequestItems {
+		table, err := s.getTable(tableName)
+		if err != nil {
+			return nil, err
+		}
+
+		write := tableWrite{table: table, puts: make(map[string]dynamoItem)}
+		for _, writeRequest := range writeRequests {
+			switch {
+			case writeRequest.PutRequest != nil:
+				key, err := table.encodeKey(writeRequest.PutRequest.Item)
+				if err != nil {
+					return nil, err
+				}
+				write.puts[key] = writeRequest.PutRequest.Item
+			case writeRequest.DeleteRequest != nil:
+				key, err := table.encodeKey(writeRequest.DeleteRequest.Key)
+				if err != nil {
+					return nil, err
+				}
+				delete(write.puts, key)
+				write.deletes = append(write.deletes, key)
+			}
+		}
+		writes = append(writes, write)
+	}
+
+	for _, write := range writes {
+		err := s.saveItems(write.table, func(items map[string]dynamoItem) {
+			for _, key := range write.deletes {
+				delete(items, key)
+			}
+			for key, item := range write.puts {
+				items[key] = item
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{"UnprocessedItems": map[string]interface{}{}}, nil
+}
@@ -0,0 +1,1019 @@
+package local_services
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// attributeValue is a DynamoDB attribute value, e.g. {"S": "hello"}.
+type attributeValue map[string]interface{}
+
+// dynamoItem is a DynamoDB item.
+type dynamoItem map[string]attributeValue
+
+// toAttributeValue converts a decoded JSON value into an attribute value.
+func toAttributeValue(value interface{}) (attributeValue, bool) {
+	switch v := value.(type) {
+	case attributeValue:
+		return v, true
+	case map[string]interface{}:
+		return attributeValue(v), true
+	}
+
+	return nil, false
+}
+
+// attributeValueType returns the type of an attribute value, e.g. "S".
+func attributeValueType(value attributeValue) string {
+	for valueType := range value {
+		return valueType
+	}
+
+	return ""
+}
+
+// parseNumber parses the value of a number attribute.
+func parseNumber(value attributeValue) (*big.Float, bool) {
+	text, ok := value["N"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	number, _, err := big.ParseFloat(text, 10, 256, big.ToNearestEven)
+	return number, err == nil
+}
+
+// formatNumber formats a number for a number attribute.
+func formatNumber(number *big.Float) string {
+	return number.Text('g', -1)
+}
+
+// compareAttributeValues orders two strings, numbers, or binaries. It returns false
+// if the values can't be ordered.
+func compareAttributeValues(a, b attributeValue) (int, bool) {
+	switch {
+	case a["S"] != nil && b["S"] != nil:
+		aString, aOK := a["S"].(string)
+		bString, bOK := b["S"].(string)
+		return strings.Compare(aString, bString), aOK && bOK
+	case a["N"] != nil && b["N"] != nil:
+		aNumber, aOK := parseNumber(a)
+		bNumber, bOK := parseNumber(b)
+		if !aOK || !bOK {
+			return 0, false
+		}
+		return aNumber.Cmp(bNumber), true
+	case a["B"] != nil && b["B"] != nil:
+		aString, _ := a["B"].(string)
+		bString, _ := b["B"].(string)
+		aBytes, aErr := base64.StdEncoding.DecodeString(aString)
+		bBytes, bErr := base64.StdEncoding.DecodeString(bString)
+		return bytes.Compare(aBytes, bBytes), aErr == nil && bErr == nil
+	}
+
+	return 0, false
+}
+
+// attributeValuesEqual checks if two attribute values are the same.
+func attributeValuesEqual(a, b attributeValue) bool {
+	if comparison, ok := compareAttributeValues(a, b); ok {
+		return comparison == 0
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// getPath gets the value at a document path in an item.
+func getPath(item dynamoItem, documentPath []string) (attributeValue, bool) {
+	value, ok := item[documentPath[0]]
+	for _, name := range documentPath[1:] {
+		if !ok {
+			return nil, false
+		}
+
+		fields, isMap := value["M"].(map[string]interface{})
+		if !isMap {
+			return nil, false
+		}
+		value, ok = toAttributeValue(fields[name])
+	}
+
+	return value, ok
+}
+
+// setPath sets the value at a document path in an item. Missing maps along the path
+// are only created if createMissing is set. Maps along the path are copied so the
+// original item is never changed.
+func setPath(item dynamoItem, documentPath []string, value attributeValue, createMissing bool) error {
+	if len(documentPath) == 1 {
+		item[documentPath[0]] = value
+		return nil
+	}
+
+	parent, ok := item[documentPath[0]]
+	fields, isMap := parent["M"].(map[string]interface{})
+	if !ok || !isMap {
+		if !createMissing {
+			return fmt.Errorf("The document path provided in the update expression is invalid for update")
+		}
+		fields = make(map[string]interface{})
+	}
+
+	nestedItem := make(dynamoItem)
+	for key, fieldValue := range fields {
+		nestedItem[key], _ = toAttributeValue(fieldValue)
+	}
+
+	err := setPath(nestedItem, documentPath[1:], value, createMissing)
+	if err != nil {
+		return err
+	}
+
+	newFields := make(map[string]interface{})
+	for key, fieldValue := range nestedItem {
+		newFields[key] = fieldValue
+	}
+	item[documentPath[0]] = attributeValue{"M": newFields}
+	return nil
+}
+
+// removePath removes the value at a document path in an item.
+func removePath(item dynamoItem, documentPath []string) {
+	if len(documentPath) == 1 {
+		delete(item, documentPath[0])
+		return
+	}
+
+	parent, ok := item[documentPath[0]]
+	fields, isMap := parent["M"].(map[string]interface{})
+	if !ok || !isMap {
+		return
+	}
+
+	nestedItem := make(dynamoItem)
+	for key, fieldValue := range fields {
+		nestedItem[key], _ = toAttributeValue(fieldValue)
+	}
+	removePath(nestedItem, documentPath[1:])
+
+	newFields := make(map[string]interface{})
+	for key, fieldValue := range nestedItem {
+		newFields[key] = fieldValue
+	}
+	item[documentPath[0]] = attributeValue{"M": newFields}
+}
+
+// copyItem makes a shallow copy of an item.
+func copyItem(item dynamoItem) dynamoItem {
+	newItem := make(dynamoItem)
+	for key, value := range item {
+		newItem[key] = value
+	}
+
+	return newItem
+}
+
+const (
+	tokenName = iota
+	tokenValue
+	tokenOperator
+	tokenEnd
+)
+
+type expressionToken struct {
+	kind int
+	text string
+}
+
+// isNameCharacter checks if a character can be part of an attribute name.
+func isNameCharacter(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '#' || c == '.'
+}
+
+// tokenizeExpression splits an expression into names, value placeholders, and
+// operators.
+func tokenizeExpression(expression string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	characters := []rune(expression)
+	for i := 0; i < len(characters); {
+		c := characters[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == ':':
+			start := i
+			i++
+			for i < len(characters) && (unicode.IsLetter(characters[i]) || unicode.IsDigit(characters[i]) || characters[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenValue, text: string(characters[start:i])})
+		case unicode.IsLetter(c) || c == '_' || c == '#':
+			start := i
+			for i < len(characters) && isNameCharacter(characters[i]) {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenName, text: string(characters[start:i])})
+		case strings.ContainsRune("<>", c) && i+1 < len(characters) && strings.ContainsRune("=>", characters[i+1]) && !(c == '>' && characters[i+1] == '>'):
+			tokens = append(tokens, expressionToken{kind: tokenOperator, text: string(characters[i : i+2])})
+			i += 2
+		case strings.ContainsRune("=<>(),+-", c):
+			tokens = append(tokens, expressionToken{kind: tokenOperator, text: string(c)})
+			i++
+		default:
+			return nil, fmt.Errorf("Invalid expression: unexpected character %q", c)
+		}
+	}
+
+	return append(tokens, expressionToken{kind: tokenEnd}), nil
+}
+
+// expressionParser parses condition, update, and projection expressions.
+type expressionParser struct {
+	tokens []expressionToken
+	pos    int
+	names  map[string]string
+	values map[string]attributeValue
+}
+
+// newExpressionParser creates a parser for an expression.
+func newExpressionParser(expression string, names map[string]string, values map[string]attributeValue) (*expressionParser, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expressionParser{tokens: tokens, names: names, values: values}, nil
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *expressionParser) next() expressionToken {
+	token := p.tokens[p.pos]
+	if token.kind != tokenEnd {
+		p.pos++
+	}
+
+	return token
+}
+
+// isOperator checks if the next token is an operator.
+func (p *expressionParser) isOperator(operator string) bool {
+	token := p.peek()
+	return token.kind == tokenOperator && token.text == operator
+}
+
+// isKeyword checks if the next token is a keyword.
+func (p *expressionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == tokenName && strings.EqualFold(token.text, keyword)
+}
+
+// isFunction checks if the next tokens are a call to a function.
+func (p *expressionParser) isFunction(name string) bool {
+	return p.isKeyword(name) && p.tokens[p.pos+1].kind == tokenOperator && p.tokens[p.pos+1].text == "("
+}
+
+// expectOperator consumes an operator or returns an error.
+func (p *expressionParser) expectOperator(operator string) error {
+	token := p.next()
+	if token.kind != tokenOperator || token.text != operator {
+		return fmt.Errorf("Invalid expression: expected %q but found %q", operator, token.text)
+	}
+
+	return nil
+}
+
+// expectEnd returns an error if there is anything left in the expression.
+func (p *expressionParser) expectEnd() error {
+	token := p.peek()
+	if token.kind != tokenEnd {
+		return fmt.Errorf("Invalid expression: unexpected %q", token.text)
+	}
+
+	return nil
+}
+
+// parsePath parses a document path, resolving the attribute name placeholders.
+func (p *expressionParser) parsePath() ([]string, error) {
+	token := p.next()
+	if token.kind != tokenName {
+		return nil, fmt.Errorf("Invalid expression: expected an attribute name but found %q", token.text)
+	}
+
+	var documentPath []string
+	for _, name := range strings.Split(token.text, ".") {
+		if strings.HasPrefix(name, "#") {
+			resolvedName, ok := p.names[name]
+			if !ok {
+				return nil, fmt.Errorf("An expression attribute name used in the document path is not defined; attribute name: %s", name)
+			}
+			name = resolvedName
+		}
+		documentPath = append(documentPath, name)
+	}
+
+	return documentPath, nil
+}
+
+// operandFunc returns the value of an operand for an item.
+type operandFunc func(item dynamoItem) (attributeValue, bool)
+
+// parseOperand parses a value placeholder, a document path, or a size function.
+func (p *expressionParser) parseOperand() (operandFunc, error) {
+	token := p.peek()
+	if token.kind == tokenValue {
+		p.next()
+		value, ok := p.values[token.text]
+		if !ok {
+			return nil, fmt.Errorf("An expression attribute value used in expression is not defined; attribute value: %s", token.text)
+		}
+		return func(item dynamoItem) (attributeValue, bool) { return value, true }, nil
+	}
+
+	if p.isFunction("size") {
+		p.next()
+		p.next()
+		documentPath, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+
+		return func(item dynamoItem) (attributeValue, bool) {
+			value, ok := getPath(item, documentPath)
+			if !ok {
+				return nil, false
+			}
+			return attributeValue{"N": fmt.Sprintf("%d", attributeValueSize(value))}, true
+		}, nil
+	}
+
+	documentPath, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item dynamoItem) (attributeValue, bool) { return getPath(item, documentPath) }, nil
+}
+
+// attributeValueSize returns the size of a value for the size function.
+func attributeValueSize(value attributeValue) int {
+	switch v := value[attributeValueType(value)].(type) {
+	case string:
+		if value["B"] != nil {
+			decoded, _ := base64.StdEncoding.DecodeString(v)
+			return len(decoded)
+		}
+		return len(v)
+	case []interface{}:
+		return len(v)
+	case map[string]interface{}:
+		return len(v)
+	}
+
+	return 0
+}
+
+// conditionFunc checks if an item matches a condition.
+type conditionFunc func(item dynamoItem) bool
+
+// parseCondition parses a condition made up of comparisons joined by OR.
+func (p *expressionParser) parseCondition() (conditionFunc, error) {
+	left, err := p.parseAndCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAndCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		first, second := left, right
+		left = func(item dynamoItem) bool { return first(item) || second(item) }
+	}
+
+	return left, nil
+}
+
+// parseAndCondition parses comparisons joined by AND.
+func (p *expressionParser) parseAndCondition() (conditionFunc, error) {
+	left, err := p.parseNotCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("AND") {
+		p.next()
+		right, err := p.parseNotCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		first, second := left, right
+		left = func(item dynamoItem) bool { return first(item) && second(item) }
+	}
+
+	return left, nil
+}
+
+// parseNotCondition parses a comparison that may be negated.
+func (p *expressionParser) parseNotCondition() (conditionFunc, error) {
+	if p.isKeyword("NOT") {
+		p.next()
+		condition, err := p.parseNotCondition()
+		if err != nil {
+			return nil, err
+		}
+		return func(item dynamoItem) bool { return !condition(item) }, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseConditionFunction parses one of the functions that can be used as a condition.
+func (p *expressionParser) parseConditionFunction() (conditionFunc, error) {
+	name := strings.ToLower(p.next().text)
+	p.next()
+
+	documentPath, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	var argument operandFunc
+	switch name {
+	case "begins_with", "contains", "attribute_type":
+		if err = p.expectOperator(","); err != nil {
+			return nil, err
+		}
+		argument, err = p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = p.expectOperator(")"); err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "attribute_exists":
+		return func(item dynamoItem) bool {
+			_, ok := getPath(item, documentPath)
+			return ok
+		}, nil
+	case "attribute_not_exists":
+		return func(item dynamoItem) bool {
+			_, ok := getPath(item, documentPath)
+			return !ok
+		}, nil
+	case "attribute_type":
+		return func(item dynamoItem) bool {
+			value, ok := getPath(item, documentPath)
+			expectedType, _ := argument(item)
+			return ok && expectedType["S"] == attributeValueType(value)
+		}, nil
+	case "begins_with":
+		return func(item dynamoItem) bool {
+			value, ok := getPath(item, documentPath)
+			prefix, _ := argument(item)
+			if !ok {
+				return false
+			}
+			for _, valueType := range []string{"S", "B"} {
+				text, isText := value[valueType].(string)
+				prefixText, isPrefixText := prefix[valueType].(string)
+				if isText && isPrefixText {
+					if valueType == "B" {
+						textBytes, _ := base64.StdEncoding.DecodeString(text)
+						prefixBytes, _ := base64.StdEncoding.DecodeString(prefixText)
+						return bytes.HasPrefix(textBytes, prefixBytes)
+					}
+					return strings.HasPrefix(text, prefixText)
+				}
+			}
+			return false
+		}, nil
+	default:
+		return func(item dynamoItem) bool {
+			value, ok := getPath(item, documentPath)
+			operand, _ := argument(item)
+			return ok && attributeValueContains(value, operand)
+		}, nil
+	}
+}
+
+// attributeValueContains checks if a string contains a substring or a set or list
+// contains a value.
+func attributeValueContains(value, operand attributeValue) bool {
+	if text, ok := value["S"].(string); ok {
+		substring, isString := operand["S"].(string)
+		return isString && strings.Contains(text, substring)
+	}
+
+	for _, setType := range []string{"SS", "NS", "BS"} {
+		members, ok := value[setType].([]interface{})
+		if !ok {
+			continue
+		}
+		memberType := strings.TrimSuffix(setType, "S")
+		for _, member := range members {
+			if attributeValuesEqual(attributeValue{memberType: member}, operand) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if elements, ok := value["L"].([]interface{}); ok {
+		for _, element := range elements {
+			elementValue, _ := toAttributeValue(element)
+			if attributeValuesEqual(elementValue, operand) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// comparisonOperators are the operators that compare two operands.
+var comparisonOperators = map[string]bool{"=": true, "<>": true, "<": true, "<=": true, ">": true, ">=": true}
+
+// parseComparison parses a function, a comparison, BETWEEN, IN, or a condition in
+// parentheses.
+func (p *expressionParser) parseComparison() (conditionFunc, error) {
+	if p.isOperator("(") {
+		p.next()
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		return condition, p.expectOperator(")")
+	}
+
+	for _, name := range []string{"attribute_exists", "attribute_not_exists", "attribute_type", "begins_with", "contains"} {
+		if p.isFunction(name) {
+			return p.parseConditionFunction()
+		}
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isKeyword("BETWEEN") {
+		p.next()
+		lower, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isKeyword("AND") {
+			return nil, fmt.Errorf("Invalid expression: expected AND in BETWEEN")
+		}
+		p.next()
+		upper, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(item dynamoItem) bool {
+			value, ok := left(item)
+			lowerValue, _ := lower(item)
+			upperValue, _ := upper(item)
+			if !ok {
+				return false
+			}
+			lowerComparison, lowerOK := compareAttributeValues(value, lowerValue)
+			upperComparison, upperOK := compareAttributeValues(value, upperValue)
+			return lowerOK && upperOK && lowerComparison >= 0 && upperComparison <= 0
+		}, nil
+	}
+
+	if p.isKeyword("IN") {
+		p.next()
+		if err = p.expectOperator("("); err != nil {
+			return nil, err
+		}
+
+		var options []operandFunc
+		for {
+			option, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, option)
+
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+
+		return func(item dynamoItem) bool {
+			value, ok := left(item)
+			if !ok {
+				return false
+			}
+			for _, option := range options {
+				optionValue, _ := option(item)
+				if attributeValuesEqual(value, optionValue) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	operator := p.next()
+	if operator.kind != tokenOperator || !comparisonOperators[operator.text] {
+		return nil, fmt.Errorf("Invalid expression: expected a comparison but found %q", operator.text)
+	}
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item dynamoItem) bool {
+		leftValue, leftOK := left(item)
+		rightValue, rightOK := right(item)
+		if operator.text == "<>" {
+			return leftOK != rightOK || (leftOK && !attributeValuesEqual(leftValue, rightValue))
+		}
+		if !leftOK || !rightOK {
+			return false
+		}
+		if operator.text == "=" {
+			return attributeValuesEqual(leftValue, rightValue)
+		}
+
+		comparison, ok := compareAttributeValues(leftValue, rightValue)
+		if !ok {
+			return false
+		}
+		switch operator.text {
+		case "<":
+			return comparison < 0
+		case "<=":
+			return comparison <= 0
+		case ">":
+			return comparison > 0
+		default:
+			return comparison >= 0
+		}
+	}, nil
+}
+
+// parseConditionExpression parses a whole condition expression. An empty expression
+// matches everything.
+func parseConditionExpression(expression string, names map[string]string, values map[string]attributeValue) (conditionFunc, error) {
+	if strings.TrimSpace(expression) == "" {
+		return func(item dynamoItem) bool { return true }, nil
+	}
+
+	parser, err := newExpressionParser(expression, names, values)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := parser.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	return condition, parser.expectEnd()
+}
+
+// updateAction applies part of an update expression. Values are read from the
+// original item and written to the updated one.
+type updateAction func(original, updated dynamoItem) error
+
+// isUpdateClause checks if the next token starts a new update clause.
+func (p *expressionParser) isUpdateClause() bool {
+	return p.isKeyword("SET") || p.isKeyword("REMOVE") || p.isKeyword("ADD") || p.isKeyword("DELETE")
+}
+
+// parseSetOperand parses a value in a SET action.
+func (p *expressionParser) parseSetOperand() (func(item dynamoItem) (attributeValue, error), error) {
+	if p.isFunction("if_not_exists") {
+		p.next()
+		p.next()
+		documentPath, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(","); err != nil {
+			return nil, err
+		}
+		fallback, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+
+		return func(item dynamoItem) (attributeValue, error) {
+			if value, ok := getPath(item, documentPath); ok {
+				return value, nil
+			}
+			value, _ := fallback(item)
+			return value, nil
+		}, nil
+	}
+
+	// The lists can themselves be if_not_exists calls.
+	if p.isFunction("list_append") {
+		p.next()
+		p.next()
+		first, err := p.parseSetOperand()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(","); err != nil {
+			return nil, err
+		}
+		second, err := p.parseSetOperand()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+
+		return func(item dynamoItem) (attributeValue, error) {
+			firstValue, err := first(item)
+			if err != nil {
+				return nil, err
+			}
+			secondValue, err := second(item)
+			if err != nil {
+				return nil, err
+			}
+			firstList, firstOK := firstValue["L"].([]interface{})
+			secondList, secondOK := secondValue["L"].([]interface{})
+			if !firstOK || !secondOK {
+				return nil, fmt.Errorf("Invalid UpdateExpression: Incorrect operand type for operator or function; operator or function: list_append")
+			}
+			return attributeValue{"L": append(append([]interface{}{}, firstList...), secondList...)}, nil
+		}, nil
+	}
+
+	operand, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item dynamoItem) (attributeValue, error) {
+		value, ok := operand(item)
+		if !ok {
+			return nil, fmt.Errorf("The provided expression refers to an attribute that does not exist in the item")
+		}
+		return value, nil
+	}, nil
+}
+
+// parseSetValue parses the value of a SET action, including + and -.
+func (p *expressionParser) parseSetValue() (func(item dynamoItem) (attributeValue, error), error) {
+	left, err := p.parseSetOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator("+") && !p.isOperator("-") {
+		return left, nil
+	}
+
+	operator := p.next().text
+	right, err := p.parseSetOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item dynamoItem) (attributeValue, error) {
+		leftValue, err := left(item)
+		if err != nil {
+			return nil, err
+		}
+		rightValue, err := right(item)
+		if err != nil {
+			return nil, err
+		}
+
+		leftNumber, leftOK := parseNumber(leftValue)
+		rightNumber, rightOK := parseNumber(rightValue)
+		if !leftOK || !rightOK {
+			return nil, fmt.Errorf("An operand in the update expression has an incorrect data type")
+		}
+
+		result := new(big.Float).SetPrec(256)
+		if operator == "+" {
+			result.Add(leftNumber, rightNumber)
+		} else {
+			result.Sub(leftNumber, rightNumber)
+		}
+		return attributeValue{"N": formatNumber(result)}, nil
+	}, nil
+}
+
+// addToAttributeValue adds a number to a number or merges a set into a set for ADD.
+func addToAttributeValue(existing attributeValue, exists bool, value attributeValue) (attributeValue, error) {
+	if !exists {
+		return value, nil
+	}
+
+	if existingNumber, ok := parseNumber(existing); ok {
+		number, ok := parseNumber(value)
+		if !ok {
+			return nil, fmt.Errorf("An operand in the update expression has an incorrect data type")
+		}
+		return attributeValue{"N": formatNumber(new(big.Float).SetPrec(256).Add(existingNumber, number))}, nil
+	}
+
+	return changeSet(existing, value, true)
+}
+
+// changeSet adds the members of one set to another or removes them.
+func changeSet(existing attributeValue, value attributeValue, add bool) (attributeValue, error) {
+	setType := attributeValueType(existing)
+	existingMembers, existingOK := existing[setType].([]interface{})
+	members, ok := value[setType].([]interface{})
+	if setType == "" || !strings.HasSuffix(setType, "S") || setType == "S" || !existingOK || !ok {
+		return nil, fmt.Errorf("An operand in the update expression has an incorrect data type")
+	}
+
+	var result []interface{}
+	memberType := strings.TrimSuffix(setType, "S")
+	containsMember := func(set []interface{}, member interface{}) bool {
+		for _, setMember := range set {
+			if attributeValuesEqual(attributeValue{memberType: setMember}, attributeValue{memberType: member}) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, member := range existingMembers {
+		if add || !containsMember(members, member) {
+			result = append(result, member)
+		}
+	}
+	if add {
+		for _, member := range members {
+			if !containsMember(result, member) {
+				result = append(result, member)
+			}
+		}
+	}
+
+	return attributeValue{setType: result}, nil
+}
+
+// parseUpdateExpression parses the SET, REMOVE, ADD, and DELETE clauses of an
+// update expression.
+func parseUpdateExpression(expression string, names map[string]string, values map[string]attributeValue) ([]updateAction, error) {
+	parser, err := newExpressionParser(expression, names, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []updateAction
+	for parser.peek().kind != tokenEnd {
+		if !parser.isUpdateClause() {
+			return nil, fmt.Errorf("Invalid UpdateExpression: unexpected %q", parser.peek().text)
+		}
+		clause := strings.ToUpper(parser.next().text)
+
+		for {
+			documentPath, err := parser.parsePath()
+			if err != nil {
+				return nil, err
+			}
+
+			switch clause {
+			case "SET":
+				if err = parser.expectOperator("="); err != nil {
+					return nil, err
+				}
+				value, err := parser.parseSetValue()
+				if err != nil {
+					return nil, err
+				}
+				actions = append(actions, func(original, updated dynamoItem) error {
+					newValue, err := value(original)
+					if err != nil {
+						return err
+					}
+					return setPath(updated, documentPath, newValue, false)
+				})
+			case "REMOVE":
+				actions = append(actions, func(original, updated dynamoItem) error {
+					removePath(updated, documentPath)
+					return nil
+				})
+			case "ADD", "DELETE":
+				operand, err := parser.parseOperand()
+				if err != nil {
+					return nil, err
+				}
+				add := clause == "ADD"
+				actions = append(actions, func(original, updated dynamoItem) error {
+					value, _ := operand(original)
+					existing, exists := getPath(original, documentPath)
+
+					var newValue attributeValue
+					var err error
+					if add {
+						newValue, err = addToAttributeValue(existing, exists, value)
+					} else if exists {
+						newValue, err = changeSet(existing, value, false)
+					} else {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+
+					// Sets can't be empty so removing every member removes the attribute.
+					if members, ok := newValue[attributeValueType(newValue)].([]interface{}); ok && len(members) == 0 && !add {
+						removePath(updated, documentPath)
+						return nil
+					}
+					return setPath(updated, documentPath, newValue, false)
+				})
+			}
+
+			if !parser.isOperator(",") {
+				break
+			}
+			parser.next()
+		}
+	}
+
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("Invalid UpdateExpression: The expression can not be empty")
+	}
+
+	return actions, nil
+}
+
+// parseProjectionExpression parses the document paths in a projection expression.
+func parseProjectionExpression(expression string, names map[string]string) ([][]string, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	parser, err := newExpressionParser(expression, names, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var documentPaths [][]string
+	for {
+		documentPath, err := parser.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		documentPaths = append(documentPaths, documentPath)
+
+		if !parser.isOperator(",") {
+			break
+		}
+		parser.next()
+	}
+
+	return documentPaths, parser.expectEnd()
+}
+
+// projectItem keeps only the projected attributes of an item.
+func projectItem(item dynamoItem, documentPaths [][]string) dynamoItem {
+	if len(documentPaths) == 0 {
+		return item
+	}
+
+	projectedItem := make(dynamoItem)
+	for _, documentPath := range documentPaths {
+		if value, ok := getPath(item, documentPath); ok {
+			setPath(projectedItem, documentPath, value, true)
+		}
+	}
+
+	return projectedItem
+}
@@ -0,0 +1,367 @@
+package local_services
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// decodeItem decodes an item written in the DynamoDB JSON format.
+func decodeItem(t *testing.T, contents string) dynamoItem {
+	t.Helper()
+
+	var item dynamoItem
+	if err := json.Unmarshal([]byte(contents), &item); err != nil {
+		t.Fatalf("Error decoding item %s: %v", contents, err)
+	}
+
+	return item
+}
+
+// decodeValues decodes expression attribute values written in the DynamoDB JSON format.
+func decodeValues(t *testing.T, contents string) map[string]attributeValue {
+	t.Helper()
+
+	if contents == "" {
+		return nil
+	}
+
+	var values map[string]attributeValue
+	if err := json.Unmarshal([]byte(contents), &values); err != nil {
+		t.Fatalf("Error decoding values %s: %v", contents, err)
+	}
+
+	return values
+}
+
+// itemJSON encodes an item the way it is sent to clients, so items can be compared
+// no matter which map types their nested values were built with.
+func itemJSON(t *testing.T, item interface{}) string {
+	t.Helper()
+
+	contents, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Error encoding item: %v", err)
+	}
+
+	return string(contents)
+}
+
+const expressionTestItem = `{
+	"id": {"S": "user-1"},
+	"age": {"N": "42"},
+	"name": {"S": "Stevie"},
+	"tags": {"SS": ["admin", "beta"]},
+	"scores": {"L": [{"N": "1"}, {"N": "2"}]},
+	"address": {"M": {"city": {"S": "Seattle"}, "zip": {"S": "98101"}}},
+	"avatar": {"B": "aGVsbG8="},
+	"active": {"BOOL": true}
+}`
+
+func TestParseConditionExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		names      map[string]string
+		values     string
+		want       bool
+		wantErr    bool
+	}{
+		{name: "empty matches everything", expression: "", want: true},
+		{name: "equal string", expression: "id = :id", values: `{":id": {"S": "user-1"}}`, want: true},
+		{name: "equal string mismatch", expression: "id = :id", values: `{":id": {"S": "user-2"}}`, want: false},
+		{name: "numbers compare by value", expression: "age = :age", values: `{":age": {"N": "42.0"}}`, want: true},
+		{name: "not equal", expression: "age <> :age", values: `{":age": {"N": "41"}}`, want: true},
+		{name: "not equal missing attribute", expression: "missing <> :age", values: `{":age": {"N": "41"}}`, want: true},
+		{name: "less than", expression: "age < :age", values: `{":age": {"N": "100"}}`, want: true},
+		{name: "numbers are not compared as strings", expression: "age < :age", values: `{":age": {"N": "5"}}`, want: false},
+		{name: "greater or equal", expression: "age >= :age", values: `{":age": {"N": "42"}}`, want: true},
+		{name: "mismatched types don't compare", expression: "age > :age", values: `{":age": {"S": "1"}}`, want: false},
+		{name: "between", expression: "age BETWEEN :low AND :high", values: `{":low": {"N": "40"}, ":high": {"N": "42"}}`, want: true},
+		{name: "between outside", expression: "age between :low and :high", values: `{":low": {"N": "43"}, ":high": {"N": "50"}}`, want: false},
+		{name: "in", expression: "name IN (:a, :b)", values: `{":a": {"S": "Sam"}, ":b": {"S": "Stevie"}}`, want: true},
+		{name: "in missing", expression: "name IN (:a)", values: `{":a": {"S": "Sam"}}`, want: false},
+		{name: "attribute name placeholder", expression: "#n = :name", names: map[string]string{"#n": "name"}, values: `{":name": {"S": "Stevie"}}`, want: true},
+		{name: "nested path", expression: "address.city = :city", values: `{":city": {"S": "Seattle"}}`, want: true},
+		{name: "nested path placeholders", expression: "#a.#c = :city", names: map[string]string{"#a": "address", "#c": "city"}, values: `{":city": {"S": "Seattle"}}`, want: true},
+		{name: "attribute_exists", expression: "attribute_exists(name)", want: true},
+		{name: "attribute_exists nested", expression: "attribute_exists(address.zip)", want: true},
+		{name: "attribute_not_exists", expression: "attribute_not_exists(missing)", want: true},
+		{name: "attribute_not_exists present", expression: "attribute_not_exists(id)", want: false},
+		{name: "attribute_type", expression: "attribute_type(tags, :type)", values: `{":type": {"S": "SS"}}`, want: true},
+		{name: "begins_with", expression: "begins_with(id, :prefix)", values: `{":prefix": {"S": "user-"}}`, want: true},
+		{name: "begins_with binary", expression: "begins_with(avatar, :prefix)", values: `{":prefix": {"B": "aGVs"}}`, want: true},
+		{name: "begins_with mismatch", expression: "begins_with(id, :prefix)", values: `{":prefix": {"S": "post-"}}`, want: false},
+		{name: "contains substring", expression: "contains(name, :part)", values: `{":part": {"S": "tev"}}`, want: true},
+		{name: "contains set member", expression: "contains(tags, :tag)", values: `{":tag": {"S": "beta"}}`, want: true},
+		{name: "contains list element", expression: "contains(scores, :score)", values: `{":score": {"N": "2"}}`, want: true},
+		{name: "contains missing member", expression: "contains(tags, :tag)", values: `{":tag": {"S": "owner"}}`, want: false},
+		{name: "size", expression: "size(tags) = :size", values: `{":size": {"N": "2"}}`, want: true},
+		{name: "size of binary", expression: "size(avatar) = :size", values: `{":size": {"N": "5"}}`, want: true},
+		{name: "and", expression: "id = :id AND age > :age", values: `{":id": {"S": "user-1"}, ":age": {"N": "40"}}`, want: true},
+		{name: "or", expression: "id = :other OR age > :age", values: `{":other": {"S": "user-2"}, ":age": {"N": "40"}}`, want: true},
+		{name: "and binds tighter than or", expression: "id = :other AND age > :age OR name = :name", values: `{":other": {"S": "user-2"}, ":age": {"N": "40"}, ":name": {"S": "Stevie"}}`, want: true},
+		{name: "parentheses", expression: "id = :other AND (age > :age OR name = :name)", values: `{":other": {"S": "user-2"}, ":age": {"N": "40"}, ":name": {"S": "Stevie"}}`, want: false},
+		{name: "not", expression: "NOT id = :id", values: `{":id": {"S": "user-1"}}`, want: false},
+		{name: "equal bool", expression: "active = :active", values: `{":active": {"BOOL": true}}`, want: true},
+		{name: "undefined value", expression: "id = :id", wantErr: true},
+		{name: "undefined name", expression: "#id = :id", values: `{":id": {"S": "user-1"}}`, wantErr: true},
+		{name: "missing operand", expression: "id =", wantErr: true},
+		{name: "unknown operator", expression: "id ! :id", values: `{":id": {"S": "user-1"}}`, wantErr: true},
+		{name: "trailing tokens", expression: "id = :id :id", values: `{":id": {"S": "user-1"}}`, wantErr: true},
+		{name: "unclosed parenthesis", expression: "(id = :id", values: `{":id": {"S": "user-1"}}`, wantErr: true},
+		{name: "between without and", expression: "age BETWEEN :low :high", values: `{":low": {"N": "1"}, ":high": {"N": "2"}}`, wantErr: true},
+	}
+
+	item := decodeItem(t, expressionTestItem)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			condition, err := parseConditionExpression(test.expression, test.names, decodeValues(t, test.values))
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error parsing %q", test.expression)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error parsing %q: %v", test.expression, err)
+			}
+
+			if got := condition(item); got != test.want {
+				t.Errorf("%q = %v, want %v", test.expression, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseUpdateExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		names      map[string]string
+		values     string
+		item       string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "set",
+			expression: "SET name = :name",
+			values:     `{":name": {"S": "Sam"}}`,
+			item:       `{"id": {"S": "1"}, "name": {"S": "Stevie"}}`,
+			want:       `{"id": {"S": "1"}, "name": {"S": "Sam"}}`,
+		},
+		{
+			name:       "set several with placeholders",
+			expression: "SET #n = :name, age = :age",
+			names:      map[string]string{"#n": "name"},
+			values:     `{":name": {"S": "Sam"}, ":age": {"N": "3"}}`,
+			item:       `{"id": {"S": "1"}}`,
+			want:       `{"id": {"S": "1"}, "name": {"S": "Sam"}, "age": {"N": "3"}}`,
+		},
+		{
+			name:       "set arithmetic",
+			expression: "SET age = age + :one, count = count - :one",
+			values:     `{":one": {"N": "1"}}`,
+			item:       `{"id": {"S": "1"}, "age": {"N": "41"}, "count": {"N": "0.5"}}`,
+			want:       `{"id": {"S": "1"}, "age": {"N": "42"}, "count": {"N": "-0.5"}}`,
+		},
+		{
+			name:       "set reads the original item",
+			expression: "SET a = b, b = a",
+			item:       `{"id": {"S": "1"}, "a": {"S": "x"}, "b": {"S": "y"}}`,
+			want:       `{"id": {"S": "1"}, "a": {"S": "y"}, "b": {"S": "x"}}`,
+		},
+		{
+			name:       "if_not_exists",
+			expression: "SET views = if_not_exists(views, :zero) + :one, name = if_not_exists(name, :name)",
+			values:     `{":zero": {"N": "0"}, ":one": {"N": "1"}, ":name": {"S": "Sam"}}`,
+			item:       `{"id": {"S": "1"}, "name": {"S": "Stevie"}}`,
+			want:       `{"id": {"S": "1"}, "name": {"S": "Stevie"}, "views": {"N": "1"}}`,
+		},
+		{
+			name:       "list_append",
+			expression: "SET scores = list_append(if_not_exists(scores, :empty), :more)",
+			values:     `{":empty": {"L": []}, ":more": {"L": [{"N": "3"}]}}`,
+			item:       `{"id": {"S": "1"}, "scores": {"L": [{"N": "1"}]}}`,
+			want:       `{"id": {"S": "1"}, "scores": {"L": [{"N": "1"}, {"N": "3"}]}}`,
+		},
+		{
+			name:       "set nested",
+			expression: "SET address.city = :city",
+			values:     `{":city": {"S": "Portland"}}`,
+			item:       `{"id": {"S": "1"}, "address": {"M": {"city": {"S": "Seattle"}, "zip": {"S": "98101"}}}}`,
+			want:       `{"id": {"S": "1"}, "address": {"M": {"city": {"S": "Portland"}, "zip": {"S": "98101"}}}}`,
+		},
+		{
+			name:       "remove",
+			expression: "REMOVE name, address.zip",
+			item:       `{"id": {"S": "1"}, "name": {"S": "Stevie"}, "address": {"M": {"city": {"S": "Seattle"}, "zip": {"S": "98101"}}}}`,
+			want:       `{"id": {"S": "1"}, "address": {"M": {"city": {"S": "Seattle"}}}}`,
+		},
+		{
+			name:       "add number",
+			expression: "ADD age :two",
+			values:     `{":two": {"N": "2"}}`,
+			item:       `{"id": {"S": "1"}, "age": {"N": "40"}}`,
+			want:       `{"id": {"S": "1"}, "age": {"N": "42"}}`,
+		},
+		{
+			name:       "add to missing attribute",
+			expression: "ADD tags :tags",
+			values:     `{":tags": {"SS": ["a"]}}`,
+			item:       `{"id": {"S": "1"}}`,
+			want:       `{"id": {"S": "1"}, "tags": {"SS": ["a"]}}`,
+		},
+		{
+			name:       "add set members",
+			expression: "ADD tags :tags",
+			values:     `{":tags": {"SS": ["b", "c"]}}`,
+			item:       `{"id": {"S": "1"}, "tags": {"SS": ["a", "b"]}}`,
+			want:       `{"id": {"S": "1"}, "tags": {"SS": ["a", "b", "c"]}}`,
+		},
+		{
+			name:       "delete set members",
+			expression: "DELETE tags :tags",
+			values:     `{":tags": {"SS": ["a"]}}`,
+			item:       `{"id": {"S": "1"}, "tags": {"SS": ["a", "b"]}}`,
+			want:       `{"id": {"S": "1"}, "tags": {"SS": ["b"]}}`,
+		},
+		{
+			name:       "delete every set member removes the attribute",
+			expression: "DELETE tags :tags",
+			values:     `{":tags": {"SS": ["a", "b"]}}`,
+			item:       `{"id": {"S": "1"}, "tags": {"SS": ["a", "b"]}}`,
+			want:       `{"id": {"S": "1"}}`,
+		},
+		{
+			name:       "several clauses",
+			expression: "SET name = :name REMOVE age ADD views :one",
+			values:     `{":name": {"S": "Sam"}, ":one": {"N": "1"}}`,
+			item:       `{"id": {"S": "1"}, "age": {"N": "40"}}`,
+			want:       `{"id": {"S": "1"}, "name": {"S": "Sam"}, "views": {"N": "1"}}`,
+		},
+		{
+			name:       "arithmetic on a string",
+			expression: "SET name = name + :one",
+			values:     `{":one": {"N": "1"}}`,
+			item:       `{"id": {"S": "1"}, "name": {"S": "Stevie"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "set from a missing attribute",
+			expression: "SET name = missing",
+			item:       `{"id": {"S": "1"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "set nested in a missing map",
+			expression: "SET address.city = :city",
+			values:     `{":city": {"S": "Portland"}}`,
+			item:       `{"id": {"S": "1"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "add a string to a number",
+			expression: "ADD age :name",
+			values:     `{":name": {"S": "Sam"}}`,
+			item:       `{"id": {"S": "1"}, "age": {"N": "40"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "empty expression",
+			expression: "",
+			item:       `{"id": {"S": "1"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown clause",
+			expression: "UPDATE name = :name",
+			values:     `{":name": {"S": "Sam"}}`,
+			item:       `{"id": {"S": "1"}}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := decodeItem(t, test.item)
+			updated := copyItem(original)
+
+			actions, err := parseUpdateExpression(test.expression, test.names, decodeValues(t, test.values))
+			for i := 0; err == nil && i < len(actions); i++ {
+				err = actions[i](original, updated)
+			}
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error applying %q", test.expression)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error applying %q: %v", test.expression, err)
+			}
+
+			if got, want := itemJSON(t, updated), itemJSON(t, decodeItem(t, test.want)); got != want {
+				t.Errorf("%q gave %s, want %s", test.expression, got, want)
+			}
+			if itemJSON(t, original) == itemJSON(t, updated) {
+				t.Errorf("%q didn't change the item", test.expression)
+			}
+		})
+	}
+}
+
+func TestParseUpdateExpressionKeepsOriginal(t *testing.T) {
+	original := decodeItem(t, `{"id": {"S": "1"}, "address": {"M": {"city": {"S": "Seattle"}}}}`)
+	updated := copyItem(original)
+
+	actions, err := parseUpdateExpression("SET address.city = :city", nil, decodeValues(t, `{":city": {"S": "Portland"}}`))
+	if err != nil {
+		t.Fatalf("Error parsing update: %v", err)
+	}
+	if err = actions[0](original, updated); err != nil {
+		t.Fatalf("Error applying update: %v", err)
+	}
+
+	if got, want := itemJSON(t, original), `{"address":{"M":{"city":{"S":"Seattle"}}},"id":{"S":"1"}}`; got != want {
+		t.Errorf("Update changed the original item to %s", got)
+	}
+}
+
+func TestProjectItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		names      map[string]string
+		want       string
+		wantErr    bool
+	}{
+		{name: "empty keeps everything", expression: "", want: expressionTestItem},
+		{name: "attributes", expression: "id, age", want: `{"id": {"S": "user-1"}, "age": {"N": "42"}}`},
+		{name: "placeholders", expression: "#n", names: map[string]string{"#n": "name"}, want: `{"name": {"S": "Stevie"}}`},
+		{name: "nested", expression: "address.city", want: `{"address": {"M": {"city": {"S": "Seattle"}}}}`},
+		{name: "missing attributes are left out", expression: "id, missing", want: `{"id": {"S": "user-1"}}`},
+		{name: "undefined name", expression: "#n", wantErr: true},
+		{name: "trailing comma", expression: "id,", wantErr: true},
+	}
+
+	item := decodeItem(t, expressionTestItem)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			documentPaths, err := parseProjectionExpression(test.expression, test.names)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error parsing %q", test.expression)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error parsing %q: %v", test.expression, err)
+			}
+
+			if got, want := itemJSON(t, projectItem(item, documentPaths)), itemJSON(t, decodeItem(t, test.want)); got != want {
+				t.Errorf("%q gave %s, want %s", test.expression, got, want)
+			}
+		})
+	}
+}
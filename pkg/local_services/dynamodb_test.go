@@ -0,0 +1,319 @@
+package local_services
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+// newTestDynamoDB creates a DynamoDB service storing its tables in a temporary
+// directory. The returned function removes the directory.
+func newTestDynamoDB(t *testing.T) (*dynamoDBService, func()) {
+	t.Helper()
+
+	dataDirectory, err := ioutil.TempDir("", "stevie-dynamodb")
+	if err != nil {
+		t.Fatalf("Error creating data directory: %v", err)
+	}
+	cleanup := func() { os.RemoveAll(dataDirectory) }
+
+	service, err := newDynamoDBService(dataDirectory, "eu-west-1")
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error creating DynamoDB service: %v", err)
+	}
+
+	return service, cleanup
+}
+
+// runOperation runs a DynamoDB operation and decodes its response.
+func runOperation(t *testing.T, service *dynamoDBService, operation, body string) map[string]interface{} {
+	t.Helper()
+
+	response, err := service.handleOperation(operation, []byte(body))
+	if err != nil {
+		t.Fatalf("Error running %s %s: %v", operation, body, err)
+	}
+
+	var decoded map[string]interface{}
+	if err = json.Unmarshal([]byte(itemJSON(t, response)), &decoded); err != nil {
+		t.Fatalf("Error decoding %s response: %v", operation, err)
+	}
+
+	return decoded
+}
+
+// createPostsTable creates a table keyed by user and post number with an index by
+// title, and fills it with posts.
+func createPostsTable(t *testing.T, service *dynamoDBService, posts ...string) {
+	t.Helper()
+
+	runOperation(t, service, "CreateTable", `{
+		"TableName": "posts",
+		"KeySchema": [{"AttributeName": "user", "KeyType": "HASH"}, {"AttributeName": "number", "KeyType": "RANGE"}],
+		"AttributeDefinitions": [
+			{"AttributeName": "user", "AttributeType": "S"},
+			{"AttributeName": "number", "AttributeType": "N"},
+			{"AttributeName": "title", "AttributeType": "S"}
+		],
+		"GlobalSecondaryIndexes": [{"IndexName": "byTitle", "KeySchema": [{"AttributeName": "title", "KeyType": "HASH"}]}]
+	}`)
+
+	for _, post := range posts {
+		runOperation(t, service, "PutItem", `{"TableName": "posts", "Item": `+post+`}`)
+	}
+}
+
+// post builds a post item.
+func post(user, number, title string) string {
+	return `{"user": {"S": "` + user + `"}, "number": {"N": "` + number + `"}, "title": {"S": "` + title + `"}}`
+}
+
+// resultNumbers returns the post numbers of the items in a search response.
+func resultNumbers(response map[string]interface{}) string {
+	items, _ := response["Items"].([]interface{})
+
+	var numbers []string
+	for _, item := range items {
+		number := item.(map[string]interface{})["number"].(map[string]interface{})["N"].(string)
+		numbers = append(numbers, number)
+	}
+
+	return strings.Join(numbers, ",")
+}
+
+func TestSearchPagination(t *testing.T) {
+	posts := []string{
+		post("sam", "1", "b"),
+		post("sam", "2", "a"),
+		post("sam", "10", "c"),
+		post("sam", "3", "a"),
+		post("kim", "1", "a"),
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		request   string
+		pages     []string
+	}{
+		{
+			name:      "query sorts numbers by value",
+			operation: "Query",
+			request:   `"KeyConditionExpression": "#u = :user", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "sam"}}`,
+			pages:     []string{"1,2,3,10"},
+		},
+		{
+			name:      "query pages",
+			operation: "Query",
+			request:   `"KeyConditionExpression": "#u = :user", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "sam"}}, "Limit": 2`,
+			pages:     []string{"1,2", "3,10"},
+		},
+		{
+			name:      "query pages backwards",
+			operation: "Query",
+			request:   `"KeyConditionExpression": "#u = :user", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "sam"}}, "Limit": 3, "ScanIndexForward": false`,
+			pages:     []string{"10,3,2", "1"},
+		},
+		{
+			name:      "query sort key condition",
+			operation: "Query",
+			request:   `"KeyConditionExpression": "#u = :user AND #n BETWEEN :low AND :high", "ExpressionAttributeNames": {"#u": "user", "#n": "number"}, "ExpressionAttributeValues": {":user": {"S": "sam"}, ":low": {"N": "2"}, ":high": {"N": "3"}}`,
+			pages:     []string{"2,3"},
+		},
+		{
+			name:      "the limit applies before the filter",
+			operation: "Query",
+			request:   `"KeyConditionExpression": "#u = :user", "FilterExpression": "title = :title", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "sam"}, ":title": {"S": "a"}}, "Limit": 2`,
+			pages:     []string{"2", "3"},
+		},
+		{
+			name:      "index query pages items with the same index key",
+			operation: "Query",
+			request:   `"IndexName": "byTitle", "KeyConditionExpression": "title = :title", "ExpressionAttributeValues": {":title": {"S": "a"}}, "Limit": 1`,
+			pages:     []string{"1", "2", "3"},
+		},
+		{
+			name:      "scan pages",
+			operation: "Scan",
+			request:   `"Limit": 2`,
+			pages:     []string{"1,1", "2,3", "10"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, cleanup := newTestDynamoDB(t)
+			defer cleanup()
+			createPostsTable(t, service, posts...)
+
+			var startKey interface{}
+			for i, want := range test.pages {
+				request := `{"TableName": "posts", ` + test.request
+				if startKey != nil {
+					request += `, "ExclusiveStartKey": ` + itemJSON(t, startKey)
+				}
+				response := runOperation(t, service, test.operation, request+`}`)
+
+				if got := resultNumbers(response); got != want {
+					t.Errorf("Page %d has %s, want %s", i+1, got, want)
+				}
+
+				startKey = response["LastEvaluatedKey"]
+				if i < len(test.pages)-1 && startKey == nil {
+					t.Fatalf("Page %d has no LastEvaluatedKey", i+1)
+				}
+			}
+
+			if startKey != nil {
+				t.Errorf("The last page has a LastEvaluatedKey %v", startKey)
+			}
+		})
+	}
+}
+
+func TestSearchContinuesAfterDeletedStartKey(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service, post("sam", "1", "a"), post("sam", "2", "a"), post("sam", "3", "a"), post("sam", "4", "a"))
+
+	query := `{"TableName": "posts", "KeyConditionExpression": "#u = :user", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "sam"}}, "Limit": 2`
+	response := runOperation(t, service, "Query", query+`}`)
+	if got := resultNumbers(response); got != "1,2" {
+		t.Fatalf("First page has %s, want 1,2", got)
+	}
+
+	// Delete the item the page ended on before reading the next page.
+	runOperation(t, service, "DeleteItem", `{"TableName": "posts", "Key": {"user": {"S": "sam"}, "number": {"N": "2"}}}`)
+
+	response = runOperation(t, service, "Query", query+`, "ExclusiveStartKey": `+itemJSON(t, response["LastEvaluatedKey"])+`}`)
+	if got := resultNumbers(response); got != "3,4" {
+		t.Errorf("Second page has %s, want 3,4", got)
+	}
+}
+
+func TestSearchStartKeyPastTheEnd(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service, post("sam", "1", "a"))
+
+	response := runOperation(t, service, "Query", `{
+		"TableName": "posts",
+		"KeyConditionExpression": "#u = :user",
+		"ExpressionAttributeNames": {"#u": "user"},
+		"ExpressionAttributeValues": {":user": {"S": "sam"}},
+		"ExclusiveStartKey": {"user": {"S": "sam"}, "number": {"N": "5"}}
+	}`)
+	if got := resultNumbers(response); got != "" {
+		t.Errorf("Query after the last item returned %s", got)
+	}
+}
+
+func TestConditionalWrites(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service, post("sam", "1", "a"))
+
+	_, err := service.handleOperation("PutItem", []byte(`{"TableName": "posts", "Item": `+post("sam", "1", "b")+`, "ConditionExpression": "attribute_not_exists(#u)", "ExpressionAttributeNames": {"#u": "user"}}`))
+	if dynamoErr, ok := err.(*dynamoDBError); !ok || dynamoErr.Type != "ConditionalCheckFailedException" {
+		t.Fatalf("Conditional put over an existing item returned %v", err)
+	}
+
+	response := runOperation(t, service, "UpdateItem", `{
+		"TableName": "posts",
+		"Key": {"user": {"S": "sam"}, "number": {"N": "1"}},
+		"UpdateExpression": "SET title = :title ADD views :one",
+		"ConditionExpression": "title = :old",
+		"ExpressionAttributeValues": {":title": {"S": "c"}, ":old": {"S": "a"}, ":one": {"N": "1"}},
+		"ReturnValues": "ALL_NEW"
+	}`)
+	if got, want := itemJSON(t, response["Attributes"]), `{"number":{"N":"1"},"title":{"S":"c"},"user":{"S":"sam"},"views":{"N":"1"}}`; got != want {
+		t.Errorf("Update returned %s, want %s", got, want)
+	}
+
+	_, err = service.handleOperation("UpdateItem", []byte(`{"TableName": "posts", "Key": {"user": {"S": "sam"}, "number": {"N": "1"}}, "UpdateExpression": "SET #u = :user", "ExpressionAttributeNames": {"#u": "user"}, "ExpressionAttributeValues": {":user": {"S": "kim"}}}`))
+	if dynamoErr, ok := err.(*dynamoDBError); !ok || dynamoErr.Type != "ValidationException" {
+		t.Errorf("Updating a key attribute returned %v", err)
+	}
+}
+
+func TestFailedSaveLeavesTablesUnchanged(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service, post("sam", "1", "a"))
+
+	// Point the service at a directory that doesn't exist so every save fails.
+	service.DataDirectory = path.Join(service.DataDirectory, "missing")
+
+	writes := []struct {
+		operation string
+		request   string
+	}{
+		{"PutItem", `{"TableName": "posts", "Item": ` + post("sam", "2", "b") + `}`},
+		{"PutItem", `{"TableName": "posts", "Item": ` + post("sam", "1", "b") + `}`},
+		{"UpdateItem", `{"TableName": "posts", "Key": {"user": {"S": "sam"}, "number": {"N": "1"}}, "UpdateExpression": "SET title = :title", "ExpressionAttributeValues": {":title": {"S": "b"}}}`},
+		{"DeleteItem", `{"TableName": "posts", "Key": {"user": {"S": "sam"}, "number": {"N": "1"}}}`},
+		{"BatchWriteItem", `{"RequestItems": {"posts": [{"PutRequest": {"Item": ` + post("sam", "3", "c") + `}}, {"DeleteRequest": {"Key": {"user": {"S": "sam"}, "number": {"N": "1"}}}}]}}`},
+	}
+	for _, write := range writes {
+		if _, err := service.handleOperation(write.operation, []byte(write.request)); err == nil {
+			t.Errorf("%s succeeded without saving", write.operation)
+		}
+
+		response := runOperation(t, service, "Scan", `{"TableName": "posts"}`)
+		if got, want := itemJSON(t, response["Items"]), `[{"number":{"N":"1"},"title":{"S":"a"},"user":{"S":"sam"}}]`; got != want {
+			t.Errorf("After a failed %s the table has %s, want %s", write.operation, got, want)
+		}
+	}
+
+	if _, err := service.handleOperation("CreateTable", []byte(`{"TableName": "users", "KeySchema": [{"AttributeName": "id", "KeyType": "HASH"}], "AttributeDefinitions": [{"AttributeName": "id", "AttributeType": "S"}]}`)); err == nil {
+		t.Errorf("CreateTable succeeded without saving")
+	}
+	if _, ok := service.tables["users"]; ok {
+		t.Errorf("A table that wasn't saved was created")
+	}
+}
+
+func TestBatchWriteIsCheckedBeforeWriting(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service)
+
+	_, err := service.handleOperation("BatchWriteItem", []byte(`{"RequestItems": {"posts": [
+		{"PutRequest": {"Item": `+post("sam", "1", "a")+`}},
+		{"PutRequest": {"Item": {"user": {"S": "sam"}}}}
+	]}}`))
+	if err == nil {
+		t.Fatalf("A batch with an item missing its sort key succeeded")
+	}
+
+	response := runOperation(t, service, "Scan", `{"TableName": "posts"}`)
+	if got := resultNumbers(response); got != "" {
+		t.Errorf("A failed batch wrote %s", got)
+	}
+}
+
+func TestTablesAreReloaded(t *testing.T) {
+	service, cleanup := newTestDynamoDB(t)
+	defer cleanup()
+	createPostsTable(t, service, post("sam", "1", "a"), post("kim", "2", "b"))
+
+	reloaded, err := newDynamoDBService(service.DataDirectory, service.Region)
+	if err != nil {
+		t.Fatalf("Error reloading tables: %v", err)
+	}
+
+	response := runOperation(t, reloaded, "GetItem", `{"TableName": "posts", "Key": {"user": {"S": "kim"}, "number": {"N": "2"}}}`)
+	if got, want := itemJSON(t, response["Item"]), `{"number":{"N":"2"},"title":{"S":"b"},"user":{"S":"kim"}}`; got != want {
+		t.Errorf("Reloaded item is %s, want %s", got, want)
+	}
+
+	response = runOperation(t, reloaded, "DescribeTable", `{"TableName": "posts"}`)
+	table := response["Table"].(map[string]interface{})
+	if got, want := table["TableArn"], "arn:aws:dynamodb:eu-west-1:000000000000:table/posts"; got != want {
+		t.Errorf("TableArn is %v, want %s", got, want)
+	}
+}
@@ -0,0 +1,729 @@
+package local_services
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zchase/stevie/pkg/utils"
+)
+
+const (
+	// s3XMLNamespace is the namespace of S3 XML responses.
+	s3XMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	// s3DefaultMaxKeys is the most keys returned when listing a bucket.
+	s3DefaultMaxKeys = 1000
+)
+
+// s3Error is an error returned to the client in the S3 format.
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	Resource   string `xml:"Resource,omitempty"`
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// newNoSuchBucketError creates a NoSuchBucket error.
+func newNoSuchBucketError(bucket string) *s3Error {
+	return &s3Error{StatusCode: http.StatusNotFound, Code: "NoSuchBucket", Message: "The specified bucket does not exist", Resource: bucket}
+}
+
+// newNoSuchKeyError creates a NoSuchKey error.
+func newNoSuchKeyError(key string) *s3Error {
+	return &s3Error{StatusCode: http.StatusNotFound, Code: "NoSuchKey", Message: "The specified key does not exist.", Resource: key}
+}
+
+// newNotImplementedError creates an error for requests the local S3 doesn't support.
+func newNotImplementedError() *s3Error {
+	return &s3Error{StatusCode: http.StatusNotImplemented, Code: "NotImplemented", Message: "This request is not supported by the local S3"}
+}
+
+// s3ObjectMetadata is stored next to each object.
+type s3ObjectMetadata struct {
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+	Size         int64
+	Metadata     map[string]string
+}
+
+// s3Service is a file backed stand-in for S3. Each bucket is a directory with the
+// objects in an objects folder and their metadata in a metadata folder. Object
+// keys are base64 encoded to make safe file names.
+type s3Service struct {
+	DataDirectory string
+	Region        string
+
+	mutex sync.RWMutex
+}
+
+// newS3Service creates an S3 service storing its buckets in a data directory.
+func newS3Service(dataDirectory, region string) (*s3Service, error) {
+	err := os.MkdirAll(dataDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Service{DataDirectory: dataDirectory, Region: region}, nil
+}
+
+// encodeObjectKey turns an object key into a file name.
+func encodeObjectKey(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodeObjectKey turns a file name back into an object key.
+func decodeObjectKey(fileName string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(fileName)
+	return string(key), err
+}
+
+func (s *s3Service) bucketPath(bucket string) string {
+	return path.Join(s.DataDirectory, bucket)
+}
+
+func (s *s3Service) objectPath(bucket, key string) string {
+	return path.Join(s.bucketPath(bucket), "objects", encodeObjectKey(key))
+}
+
+func (s *s3Service) metadataPath(bucket, key string) string {
+	return path.Join(s.bucketPath(bucket), "metadata", encodeObjectKey(key)+".json")
+}
+
+// bucketExists checks if a bucket has been created.
+func (s *s3Service) bucketExists(bucket string) bool {
+	info, err := os.Stat(s.bucketPath(bucket))
+	return err == nil && info.IsDir()
+}
+
+// parseS3Request gets the bucket and key from a path style or virtual host style
+// request.
+func parseS3Request(r *http.Request) (string, string) {
+	host := r.Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	requestPath := strings.TrimPrefix(r.URL.Path, "/")
+	if strings.HasSuffix(host, ".localhost") {
+		return strings.TrimSuffix(host, ".localhost"), requestPath
+	}
+
+	parts := strings.SplitN(requestPath, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// ServeHTTP handles an S3 REST request.
+func (s *s3Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key := parseS3Request(r)
+	query := r.URL.Query()
+
+	var err error
+	switch {
+	case bucket == "" && r.Method == http.MethodGet:
+		err = s.listBuckets(w)
+	case bucket == "":
+		err = newNotImplementedError()
+	case strings.ContainsAny(bucket, "\\") || bucket == "." || bucket == "..":
+		err = &s3Error{StatusCode: http.StatusBadRequest, Code: "InvalidBucketName", Message: "The specified bucket is not valid.", Resource: bucket}
+	case key == "":
+		err = s.handleBucketRequest(w, r, bucket, query)
+	default:
+		err = s.handleObjectRequest(w, r, bucket, key, query)
+	}
+
+	if err != nil {
+		writeS3Error(w, r, err)
+	}
+}
+
+// writeS3Error writes an error out in the S3 format.
+func writeS3Error(w http.ResponseWriter, r *http.Request, err error) {
+	s3Err, ok := err.(*s3Error)
+	if !ok {
+		s3Err = &s3Error{StatusCode: http.StatusInternalServerError, Code: "InternalError", Message: err.Error()}
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(s3Err.StatusCode)
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	body, _ := xml.Marshal(struct {
+		XMLName xml.Name `xml:"Error"`
+		*s3Error
+	}{s3Error: s3Err})
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+// writeXML writes an XML response.
+func writeXML(w http.ResponseWriter, statusCode int, response interface{}) error {
+	body, err := xml.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+	return nil
+}
+
+type s3Bucket struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entries, err := ioutil.ReadDir(s.DataDirectory)
+	if err != nil {
+		return err
+	}
+
+	var buckets []s3Bucket
+	for _, entry := range entries {
+		if entry.IsDir() {
+			buckets = append(buckets, s3Bucket{Name: entry.Name(), CreationDate: entry.ModTime().UTC().Format(time.RFC3339)})
+		}
+	}
+
+	return writeXML(w, http.StatusOK, struct {
+		XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Owner   struct{ ID string }
+		Buckets []s3Bucket `xml:"Buckets>Bucket"`
+	}{Xmlns: s3XMLNamespace, Buckets: buckets})
+}
+
+// handleBucketRequest handles requests to a bucket.
+func (s *s3Service) handleBucketRequest(w http.ResponseWriter, r *http.Request, bucket string, query url.Values) error {
+	switch r.Method {
+	case http.MethodPut:
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		for _, folder := range []string{"objects", "metadata"} {
+			if err := os.MkdirAll(path.Join(s.bucketPath(bucket), folder), 0755); err != nil {
+				return err
+			}
+		}
+		w.Header().Set("Location", "/"+bucket)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case http.MethodHead:
+		if !s.bucketExists(bucket) {
+			return newNoSuchBucketError(bucket)
+		}
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case http.MethodDelete:
+		return s.deleteBucket(w, bucket)
+	case http.MethodPost:
+		if _, ok := query["delete"]; ok {
+			return s.deleteObjects(w, r, bucket)
+		}
+	case http.MethodGet:
+		if _, ok := query["location"]; ok {
+			if !s.bucketExists(bucket) {
+				return newNoSuchBucketError(bucket)
+			}
+			return writeXML(w, http.StatusOK, struct {
+				XMLName  xml.Name `xml:"LocationConstraint"`
+				Xmlns    string   `xml:"xmlns,attr"`
+				Location string   `xml:",chardata"`
+			}{Xmlns: s3XMLNamespace, Location: s.Region})
+		}
+		return s.listObjects(w, bucket, query)
+	}
+
+	return newNotImplementedError()
+}
+
+func (s *s3Service) deleteBucket(w http.ResponseWriter, bucket string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	objects, err := ioutil.ReadDir(path.Join(s.bucketPath(bucket), "objects"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(objects) > 0 {
+		return &s3Error{StatusCode: http.StatusConflict, Code: "BucketNotEmpty", Message: "The bucket you tried to delete is not empty", Resource: bucket}
+	}
+
+	if err = os.RemoveAll(s.bucketPath(bucket)); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// readObjectMetadata reads the metadata stored for an object.
+func (s *s3Service) readObjectMetadata(bucket, key string) (s3ObjectMetadata, error) {
+	contents, err := ioutil.ReadFile(s.metadataPath(bucket, key))
+	if os.IsNotExist(err) {
+		return s3ObjectMetadata{}, newNoSuchKeyError(key)
+	}
+	if err != nil {
+		return s3ObjectMetadata{}, err
+	}
+
+	var metadata s3ObjectMetadata
+	err = json.Unmarshal(contents, &metadata)
+	return metadata, err
+}
+
+type s3Object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type s3CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// listObjects lists the objects in a bucket. It supports both versions of the
+// ListObjects API.
+func (s *s3Service) listObjects(w http.ResponseWriter, bucket string, query url.Values) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	entries, err := ioutil.ReadDir(path.Join(s.bucketPath(bucket), "objects"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		key, err := decodeObjectKey(entry.Name())
+		if err == nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	isVersion2 := query.Get("list-type") == "2"
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	maxKeys := s3DefaultMaxKeys
+	if value, err := strconv.Atoi(query.Get("max-keys")); err == nil && value >= 0 && value < maxKeys {
+		maxKeys = value
+	}
+
+	startAfter := query.Get("marker")
+	if isVersion2 {
+		startAfter = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			decodedToken, err := decodeObjectKey(token)
+			if err != nil {
+				return &s3Error{StatusCode: http.StatusBadRequest, Code: "InvalidArgument", Message: "The continuation token provided is incorrect"}
+			}
+			startAfter = decodedToken
+		}
+	}
+
+	var objects []s3Object
+	var commonPrefixes []s3CommonPrefix
+	seenPrefixes := make(map[string]bool)
+	isTruncated := false
+	lastKey := ""
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) || key <= startAfter {
+			continue
+		}
+
+		// Keys with the delimiter after the prefix are rolled up into a common prefix.
+		commonPrefix := ""
+		if delimiter != "" {
+			if index := strings.Index(key[len(prefix):], delimiter); index != -1 {
+				commonPrefix = key[:len(prefix)+index+len(delimiter)]
+			}
+		}
+		// A page that ended on a common prefix resumes after every key in it.
+		if commonPrefix != "" && (seenPrefixes[commonPrefix] || commonPrefix == startAfter) {
+			continue
+		}
+
+		if len(objects)+len(commonPrefixes) >= maxKeys {
+			isTruncated = true
+			break
+		}
+
+		if commonPrefix != "" {
+			seenPrefixes[commonPrefix] = true
+			commonPrefixes = append(commonPrefixes, s3CommonPrefix{Prefix: commonPrefix})
+			lastKey = commonPrefix
+			continue
+		}
+
+		metadata, err := s.readObjectMetadata(bucket, key)
+		if err != nil {
+			continue
+		}
+		objects = append(objects, s3Object{
+			Key:          key,
+			LastModified: metadata.LastModified.UTC().Format(time.RFC3339),
+			ETag:         metadata.ETag,
+			Size:         metadata.Size,
+			StorageClass: "STANDARD",
+		})
+		lastKey = key
+	}
+
+	response := struct {
+		XMLName               xml.Name         `xml:"ListBucketResult"`
+		Xmlns                 string           `xml:"xmlns,attr"`
+		Name                  string           `xml:"Name"`
+		Prefix                string           `xml:"Prefix"`
+		Delimiter             string           `xml:"Delimiter,omitempty"`
+		MaxKeys               int              `xml:"MaxKeys"`
+		IsTruncated           bool             `xml:"IsTruncated"`
+		Marker                string           `xml:"Marker,omitempty"`
+		NextMarker            string           `xml:"NextMarker,omitempty"`
+		KeyCount              *int             `xml:"KeyCount,omitempty"`
+		StartAfter            string           `xml:"StartAfter,omitempty"`
+		ContinuationToken     string           `xml:"ContinuationToken,omitempty"`
+		NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+		Contents              []s3Object       `xml:"Contents"`
+		CommonPrefixes        []s3CommonPrefix `xml:"CommonPrefixes"`
+	}{
+		Xmlns:          s3XMLNamespace,
+		Name:           bucket,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+		MaxKeys:        maxKeys,
+		IsTruncated:    isTruncated,
+		Contents:       objects,
+		CommonPrefixes: commonPrefixes,
+	}
+
+	if isVersion2 {
+		keyCount := len(objects) + len(commonPrefixes)
+		response.KeyCount = &keyCount
+		response.StartAfter = query.Get("start-after")
+		response.ContinuationToken = query.Get("continuation-token")
+		if isTruncated {
+			response.NextContinuationToken = encodeObjectKey(lastKey)
+		}
+	} else {
+		response.Marker = query.Get("marker")
+		if isTruncated {
+			response.NextMarker = lastKey
+		}
+	}
+
+	return writeXML(w, http.StatusOK, response)
+}
+
+// handleObjectRequest handles requests to an object.
+func (s *s3Service) handleObjectRequest(w http.ResponseWriter, r *http.Request, bucket, key string, query url.Values) error {
+	// Multipart uploads, ACLs, tagging, and the like aren't supported.
+	for _, subresource := range []string{"uploads", "uploadId", "acl", "tagging", "versionId", "retention", "legal-hold"} {
+		if _, ok := query[subresource]; ok {
+			return newNotImplementedError()
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if copySource := r.Header.Get("X-Amz-Copy-Source"); copySource != "" {
+			return s.copyObject(w, r, bucket, key, copySource)
+		}
+		return s.putObject(w, r, bucket, key)
+	case http.MethodGet, http.MethodHead:
+		return s.getObject(w, r, bucket, key)
+	case http.MethodDelete:
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		if !s.bucketExists(bucket) {
+			return newNoSuchBucketError(bucket)
+		}
+		os.Remove(s.objectPath(bucket, key))
+		os.Remove(s.metadataPath(bucket, key))
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	return newNotImplementedError()
+}
+
+// readAWSChunkedBody decodes a body sent with the aws-chunked content encoding used
+// by streaming signed uploads.
+func readAWSChunkedBody(body io.Reader) ([]byte, error) {
+	reader := bufio.NewReader(body)
+	var decoded bytes.Buffer
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("Error reading chunk header: %v", err)
+		}
+
+		sizeText := strings.TrimSpace(strings.SplitN(header, ";", 2)[0])
+		size, err := strconv.ParseInt(sizeText, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid chunk size %q", sizeText)
+		}
+		if size == 0 {
+			return decoded.Bytes(), nil
+		}
+
+		_, err = io.CopyN(&decoded, reader, size)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading chunk: %v", err)
+		}
+
+		// Each chunk ends with a CRLF.
+		if _, err = reader.ReadString('\n'); err != nil {
+			return nil, fmt.Errorf("Error reading chunk: %v", err)
+		}
+	}
+}
+
+// readObjectBody reads the body of an upload.
+func readObjectBody(r *http.Request) ([]byte, error) {
+	isChunked := strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") ||
+		strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked")
+	if isChunked {
+		return readAWSChunkedBody(r.Body)
+	}
+
+	return ioutil.ReadAll(r.Body)
+}
+
+// writeObject stores an object and its metadata.
+func (s *s3Service) writeObject(bucket, key string, body []byte, metadata s3ObjectMetadata) error {
+	checksum := md5.Sum(body)
+	metadata.ETag = fmt.Sprintf("\"%s\"", hex.EncodeToString(checksum[:]))
+	metadata.Size = int64(len(body))
+	metadata.LastModified = time.Now().UTC()
+
+	metadataContents, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	if err = utils.WriteFileAtomically(s.objectPath(bucket, key), body); err != nil {
+		return err
+	}
+
+	return utils.WriteFileAtomically(s.metadataPath(bucket, key), metadataContents)
+}
+
+// requestMetadata reads the user metadata headers of a request.
+func requestMetadata(r *http.Request) map[string]string {
+	metadata := make(map[string]string)
+	for name, values := range r.Header {
+		lowerName := strings.ToLower(name)
+		if strings.HasPrefix(lowerName, "x-amz-meta-") && len(values) > 0 {
+			metadata[strings.TrimPrefix(lowerName, "x-amz-meta-")] = values[0]
+		}
+	}
+
+	return metadata
+}
+
+func (s *s3Service) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	body, err := readObjectBody(r)
+	if err != nil {
+		return &s3Error{StatusCode: http.StatusBadRequest, Code: "IncompleteBody", Message: err.Error()}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "binary/octet-stream"
+	}
+
+	metadata := s3ObjectMetadata{ContentType: contentType, Metadata: requestMetadata(r)}
+	if err = s.writeObject(bucket, key, body, metadata); err != nil {
+		return err
+	}
+
+	storedMetadata, err := s.readObjectMetadata(bucket, key)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", storedMetadata.ETag)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (s *s3Service) copyObject(w http.ResponseWriter, r *http.Request, bucket, key, copySource string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// The source is /bucket/key with the key URL encoded.
+	source, err := url.PathUnescape(strings.TrimPrefix(copySource, "/"))
+	if err != nil {
+		return &s3Error{StatusCode: http.StatusBadRequest, Code: "InvalidArgument", Message: "Invalid copy source"}
+	}
+	sourceParts := strings.SplitN(strings.SplitN(source, "?", 2)[0], "/", 2)
+	if len(sourceParts) != 2 {
+		return &s3Error{StatusCode: http.StatusBadRequest, Code: "InvalidArgument", Message: "Invalid copy source"}
+	}
+	sourceBucket, sourceKey := sourceParts[0], sourceParts[1]
+
+	if !s.bucketExists(sourceBucket) {
+		return newNoSuchBucketError(sourceBucket)
+	}
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	metadata, err := s.readObjectMetadata(sourceBucket, sourceKey)
+	if err != nil {
+		return err
+	}
+	body, err := ioutil.ReadFile(s.objectPath(sourceBucket, sourceKey))
+	if err != nil {
+		return err
+	}
+
+	if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+		metadata.ContentType = r.Header.Get("Content-Type")
+		metadata.Metadata = requestMetadata(r)
+	}
+
+	if err = s.writeObject(bucket, key, body, metadata); err != nil {
+		return err
+	}
+
+	copiedMetadata, err := s.readObjectMetadata(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	return writeXML(w, http.StatusOK, struct {
+		XMLName      xml.Name `xml:"CopyObjectResult"`
+		Xmlns        string   `xml:"xmlns,attr"`
+		LastModified string   `xml:"LastModified"`
+		ETag         string   `xml:"ETag"`
+	}{
+		Xmlns:        s3XMLNamespace,
+		LastModified: copiedMetadata.LastModified.Format(time.RFC3339),
+		ETag:         copiedMetadata.ETag,
+	})
+}
+
+func (s *s3Service) getObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	metadata, err := s.readObjectMetadata(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(s.objectPath(bucket, key))
+	if os.IsNotExist(err) {
+		return newNoSuchKeyError(key)
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", metadata.ContentType)
+	w.Header().Set("ETag", metadata.ETag)
+	w.Header().Set("Accept-Ranges", "bytes")
+	for name, value := range metadata.Metadata {
+		w.Header().Set("X-Amz-Meta-"+name, value)
+	}
+
+	// ServeContent handles HEAD, ranges, and conditional requests.
+	http.ServeContent(w, r, "", metadata.LastModified, file)
+	return nil
+}
+
+type deleteObjectsRequest struct {
+	Quiet   bool `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+func (s *s3Service) deleteObjects(w http.ResponseWriter, r *http.Request, bucket string) error {
+	var request deleteObjectsRequest
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		return &s3Error{StatusCode: http.StatusBadRequest, Code: "MalformedXML", Message: "The XML you provided was not well-formed or did not validate against our published schema"}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.bucketExists(bucket) {
+		return newNoSuchBucketError(bucket)
+	}
+
+	type deletedObject struct {
+		Key string `xml:"Key"`
+	}
+	var deletedObjects []deletedObject
+	for _, object := range request.Objects {
+		os.Remove(s.objectPath(bucket, object.Key))
+		os.Remove(s.metadataPath(bucket, object.Key))
+		if !request.Quiet {
+			deletedObjects = append(deletedObjects, deletedObject{Key: object.Key})
+		}
+	}
+
+	return writeXML(w, http.StatusOK, struct {
+		XMLName xml.Name        `xml:"DeleteResult"`
+		Xmlns   string          `xml:"xmlns,attr"`
+		Deleted []deletedObject `xml:"Deleted"`
+	}{Xmlns: s3XMLNamespace, Deleted: deletedObjects})
+}
@@ -0,0 +1,285 @@
+package local_services
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// newTestS3 creates an S3 service storing its buckets in a temporary directory. The
+// returned function removes the directory.
+func newTestS3(t *testing.T) (*s3Service, func()) {
+	t.Helper()
+
+	dataDirectory, err := ioutil.TempDir("", "stevie-s3")
+	if err != nil {
+		t.Fatalf("Error creating data directory: %v", err)
+	}
+	cleanup := func() { os.RemoveAll(dataDirectory) }
+
+	service, err := newS3Service(dataDirectory, "eu-west-1")
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error creating S3 service: %v", err)
+	}
+
+	return service, cleanup
+}
+
+// sendS3Request sends a request to the S3 service and returns the response.
+func sendS3Request(service *s3Service, method, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	service.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// createBucket creates a bucket with objects in it.
+func createBucket(t *testing.T, service *s3Service, bucket string, keys ...string) {
+	t.Helper()
+
+	if response := sendS3Request(service, http.MethodPut, "/"+bucket, "", nil); response.Code != http.StatusOK {
+		t.Fatalf("Creating bucket %s returned %d: %s", bucket, response.Code, response.Body.String())
+	}
+
+	for _, key := range keys {
+		if response := sendS3Request(service, http.MethodPut, "/"+bucket+"/"+key, "contents of "+key, nil); response.Code != http.StatusOK {
+			t.Fatalf("Putting %s returned %d: %s", key, response.Code, response.Body.String())
+		}
+	}
+}
+
+// listBucketResult is the part of a ListObjects response the tests check.
+type listBucketResult struct {
+	IsTruncated           bool
+	NextMarker            string
+	NextContinuationToken string
+	KeyCount              int
+	Contents              []struct{ Key string }
+	CommonPrefixes        []struct{ Prefix string }
+}
+
+// listedKeys returns the keys and common prefixes of a list response.
+func (r listBucketResult) listedKeys() string {
+	var keys []string
+	for _, object := range r.Contents {
+		keys = append(keys, object.Key)
+	}
+	for _, prefix := range r.CommonPrefixes {
+		keys = append(keys, prefix.Prefix)
+	}
+
+	return strings.Join(keys, ",")
+}
+
+func TestS3ListObjects(t *testing.T) {
+	keys := []string{"b.txt", "a.txt", "photos/2020/1.jpg", "photos/2020/2.jpg", "photos/2021/1.jpg", "photos/index.html", "z/deep/key"}
+
+	tests := []struct {
+		name  string
+		query string
+		pages []string
+	}{
+		{name: "everything in order", query: "", pages: []string{"a.txt,b.txt,photos/2020/1.jpg,photos/2020/2.jpg,photos/2021/1.jpg,photos/index.html,z/deep/key"}},
+		{name: "prefix", query: "prefix=photos/2020/", pages: []string{"photos/2020/1.jpg,photos/2020/2.jpg"}},
+		{name: "delimiter", query: "delimiter=/", pages: []string{"a.txt,b.txt,photos/,z/"}},
+		{name: "prefix and delimiter", query: "prefix=photos/&delimiter=/", pages: []string{"photos/index.html,photos/2020/,photos/2021/"}},
+		{name: "marker pages", query: "max-keys=3", pages: []string{"a.txt,b.txt,photos/2020/1.jpg", "photos/2020/2.jpg,photos/2021/1.jpg,photos/index.html", "z/deep/key"}},
+		{name: "continuation token pages", query: "list-type=2&max-keys=4", pages: []string{"a.txt,b.txt,photos/2020/1.jpg,photos/2020/2.jpg", "photos/2021/1.jpg,photos/index.html,z/deep/key"}},
+		{name: "common prefixes count towards the page", query: "list-type=2&delimiter=/&max-keys=3", pages: []string{"a.txt,b.txt,photos/", "z/"}},
+		{name: "start after", query: "list-type=2&start-after=photos/2021/1.jpg", pages: []string{"photos/index.html,z/deep/key"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, cleanup := newTestS3(t)
+			defer cleanup()
+			createBucket(t, service, "media", keys...)
+
+			isVersion2 := strings.Contains(test.query, "list-type=2")
+			next := ""
+			for i, want := range test.pages {
+				target := "/media?" + test.query
+				if next != "" && isVersion2 {
+					target += "&continuation-token=" + next
+				} else if next != "" {
+					target += "&marker=" + next
+				}
+
+				response := sendS3Request(service, http.MethodGet, target, "", nil)
+				if response.Code != http.StatusOK {
+					t.Fatalf("Listing %s returned %d: %s", target, response.Code, response.Body.String())
+				}
+
+				var result listBucketResult
+				if err := xml.Unmarshal(response.Body.Bytes(), &result); err != nil {
+					t.Fatalf("Error decoding list response: %v", err)
+				}
+
+				if got := result.listedKeys(); got != want {
+					t.Errorf("Page %d has %s, want %s", i+1, got, want)
+				}
+				if isVersion2 && result.KeyCount != len(strings.Split(want, ",")) {
+					t.Errorf("Page %d has KeyCount %d, want %d", i+1, result.KeyCount, len(strings.Split(want, ",")))
+				}
+
+				last := i == len(test.pages)-1
+				if result.IsTruncated == last {
+					t.Errorf("Page %d has IsTruncated %v", i+1, result.IsTruncated)
+				}
+
+				next = result.NextMarker
+				if isVersion2 {
+					next = result.NextContinuationToken
+				}
+				if !last && next == "" {
+					t.Fatalf("Page %d has no marker for the next page", i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestS3PutAndGetObject(t *testing.T) {
+	service, cleanup := newTestS3(t)
+	defer cleanup()
+	createBucket(t, service, "media")
+
+	response := sendS3Request(service, http.MethodPut, "/media/docs/readme.md", "# Hello", map[string]string{
+		"Content-Type":      "text/markdown",
+		"X-Amz-Meta-Author": "stevie",
+	})
+	if response.Code != http.StatusOK {
+		t.Fatalf("Put returned %d: %s", response.Code, response.Body.String())
+	}
+	etag := response.Header().Get("ETag")
+	if len(etag) != 34 || !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+		t.Errorf("Put returned the ETag %q", etag)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		headers  map[string]string
+		wantCode int
+		wantBody string
+	}{
+		{name: "get", method: http.MethodGet, target: "/media/docs/readme.md", wantCode: http.StatusOK, wantBody: "# Hello"},
+		{name: "head", method: http.MethodHead, target: "/media/docs/readme.md", wantCode: http.StatusOK, wantBody: ""},
+		{name: "range", method: http.MethodGet, target: "/media/docs/readme.md", headers: map[string]string{"Range": "bytes=2-"}, wantCode: http.StatusPartialContent, wantBody: "Hello"},
+		{name: "not modified", method: http.MethodGet, target: "/media/docs/readme.md", headers: map[string]string{"If-None-Match": etag}, wantCode: http.StatusNotModified, wantBody: ""},
+		{name: "virtual host", method: http.MethodGet, target: "http://media.localhost/docs/readme.md", wantCode: http.StatusOK, wantBody: "# Hello"},
+		{name: "missing key", method: http.MethodGet, target: "/media/docs/missing.md", wantCode: http.StatusNotFound},
+		{name: "missing bucket", method: http.MethodGet, target: "/other/docs/readme.md", wantCode: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := sendS3Request(service, test.method, test.target, "", test.headers)
+			if response.Code != test.wantCode {
+				t.Fatalf("Returned %d, want %d: %s", response.Code, test.wantCode, response.Body.String())
+			}
+			if test.wantCode >= http.StatusBadRequest {
+				return
+			}
+
+			if got := response.Body.String(); got != test.wantBody {
+				t.Errorf("Returned the body %q, want %q", got, test.wantBody)
+			}
+			if test.wantCode == http.StatusNotModified {
+				return
+			}
+			if got := response.Header().Get("Content-Type"); got != "text/markdown" {
+				t.Errorf("Returned the Content-Type %q", got)
+			}
+			if got := response.Header().Get("X-Amz-Meta-Author"); got != "stevie" {
+				t.Errorf("Returned the author metadata %q", got)
+			}
+			if got := response.Header().Get("ETag"); got != etag {
+				t.Errorf("Returned the ETag %q, want %q", got, etag)
+			}
+		})
+	}
+}
+
+func TestS3PutChunkedObject(t *testing.T) {
+	service, cleanup := newTestS3(t)
+	defer cleanup()
+	createBucket(t, service, "media")
+
+	body := "5;chunk-signature=abc\r\nHello\r\n6;chunk-signature=def\r\n world\r\n0;chunk-signature=ghi\r\n\r\n"
+	response := sendS3Request(service, http.MethodPut, "/media/greeting.txt", body, map[string]string{
+		"X-Amz-Content-Sha256": "STREAMING-AWS4-HMAC-SHA256-PAYLOAD",
+	})
+	if response.Code != http.StatusOK {
+		t.Fatalf("Chunked put returned %d: %s", response.Code, response.Body.String())
+	}
+
+	response = sendS3Request(service, http.MethodGet, "/media/greeting.txt", "", nil)
+	if got := response.Body.String(); got != "Hello world" {
+		t.Errorf("Chunked object is %q, want %q", got, "Hello world")
+	}
+	if got := response.Header().Get("Content-Type"); got != "binary/octet-stream" {
+		t.Errorf("Chunked object has the Content-Type %q", got)
+	}
+}
+
+func TestS3CopyAndDeleteObjects(t *testing.T) {
+	service, cleanup := newTestS3(t)
+	defer cleanup()
+	createBucket(t, service, "media", "a.txt", "b.txt")
+	createBucket(t, service, "backup")
+
+	response := sendS3Request(service, http.MethodPut, "/backup/copy%20of%20a.txt", "", map[string]string{"X-Amz-Copy-Source": "/media/a.txt"})
+	if response.Code != http.StatusOK {
+		t.Fatalf("Copy returned %d: %s", response.Code, response.Body.String())
+	}
+	response = sendS3Request(service, http.MethodGet, "/backup/copy%20of%20a.txt", "", nil)
+	if got := response.Body.String(); got != "contents of a.txt" {
+		t.Errorf("Copied object is %q", got)
+	}
+
+	response = sendS3Request(service, http.MethodDelete, "/media", "", nil)
+	if response.Code != http.StatusConflict {
+		t.Errorf("Deleting a bucket with objects returned %d", response.Code)
+	}
+
+	response = sendS3Request(service, http.MethodPost, "/media?delete", `<Delete><Object><Key>a.txt</Key></Object><Object><Key>b.txt</Key></Object></Delete>`, nil)
+	if response.Code != http.StatusOK {
+		t.Fatalf("Deleting objects returned %d: %s", response.Code, response.Body.String())
+	}
+
+	response = sendS3Request(service, http.MethodDelete, "/media", "", nil)
+	if response.Code != http.StatusNoContent {
+		t.Errorf("Deleting an empty bucket returned %d: %s", response.Code, response.Body.String())
+	}
+	if response = sendS3Request(service, http.MethodHead, "/media", "", nil); response.Code != http.StatusNotFound {
+		t.Errorf("The deleted bucket still exists")
+	}
+}
+
+func TestS3BucketLocation(t *testing.T) {
+	service, cleanup := newTestS3(t)
+	defer cleanup()
+	createBucket(t, service, "media")
+
+	response := sendS3Request(service, http.MethodGet, "/media?location", "", nil)
+	var location struct {
+		Location string `xml:",chardata"`
+	}
+	if err := xml.Unmarshal(response.Body.Bytes(), &location); err != nil {
+		t.Fatalf("Error decoding location: %v", err)
+	}
+	if location.Location != "eu-west-1" {
+		t.Errorf("Bucket is in %q, want eu-west-1", location.Location)
+	}
+}
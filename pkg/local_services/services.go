@@ -0,0 +1,104 @@
+package local_services
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+)
+
+var (
+	// AccountID is the AWS account ID the local services report.
+	AccountID = "000000000000"
+
+	// DefaultPort is the port the local services listen on.
+	DefaultPort = 4566
+)
+
+// Server serves the local stand-ins for DynamoDB and S3 on a single port. DynamoDB
+// requests are told apart by their X-Amz-Target header and everything else is
+// treated as an S3 request.
+type Server struct {
+	Port          int
+	DataDirectory string
+
+	// Region is the AWS region the services report and the SDKs are pointed at.
+	Region string
+
+	dynamoDB *dynamoDBService
+	s3       *s3Service
+	server   *http.Server
+}
+
+// NewServer creates the local services with their data stored in a directory. They
+// report being in region like the deployed services of the environment.
+func NewServer(dataDirectory string, port int, region string) (*Server, error) {
+	dynamoDB, err := newDynamoDBService(path.Join(dataDirectory, "dynamodb"), region)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading local DynamoDB tables: %v", err)
+	}
+
+	s3, err := newS3Service(path.Join(dataDirectory, "s3"), region)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading local S3 buckets: %v", err)
+	}
+
+	return &Server{
+		Port:          port,
+		DataDirectory: dataDirectory,
+		Region:        region,
+		dynamoDB:      dynamoDB,
+		s3:            s3,
+	}, nil
+}
+
+// ServeHTTP sends a request to the service it is for.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.Header.Get("X-Amz-Target"), dynamoDBTargetPrefix) {
+		s.dynamoDB.ServeHTTP(w, r)
+		return
+	}
+
+	s.s3.ServeHTTP(w, r)
+}
+
+// Start starts listening for requests. If the port is 0 a free port is used.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", s.Port))
+	if err != nil {
+		return fmt.Errorf("Error starting local services: %v", err)
+	}
+	s.Port = listener.Addr().(*net.TCPAddr).Port
+
+	s.server = &http.Server{Handler: s}
+	go s.server.Serve(listener)
+	return nil
+}
+
+// Stop stops the server.
+func (s *Server) Stop() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// Endpoint returns the URL of the server.
+func (s *Server) Endpoint() string {
+	return fmt.Sprintf("http://localhost:%d", s.Port)
+}
+
+// Environ returns the environment variables that point the AWS SDKs at the local
+// services, in KEY=VALUE form.
+func (s *Server) Environ() []string {
+	return []string{
+		fmt.Sprintf("AWS_ENDPOINT_URL_DYNAMODB=%s", s.Endpoint()),
+		fmt.Sprintf("AWS_ENDPOINT_URL_S3=%s", s.Endpoint()),
+		fmt.Sprintf("STEVIE_DYNAMODB_ENDPOINT=%s", s.Endpoint()),
+		fmt.Sprintf("STEVIE_S3_ENDPOINT=%s", s.Endpoint()),
+		"AWS_ACCESS_KEY_ID=local",
+		"AWS_SECRET_ACCESS_KEY=local",
+		fmt.Sprintf("AWS_REGION=%s", s.Region),
+		fmt.Sprintf("AWS_DEFAULT_REGION=%s", s.Region),
+	}
+}
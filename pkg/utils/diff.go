@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// DiffLines compares two texts line by line. It returns an empty string if they are
+// the same, otherwise the lines of both texts with removed lines prefixed with "-"
+// and added lines prefixed with "+".
+func DiffLines(oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	oldLines := strings.Split(oldText, "\n")
+	newLines := strings.Split(newText, "\n")
+
+	// Find the longest common subsequence of lines.
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Walk the subsequence to write out the diff.
+	var diff []string
+	i, j := 0, 0
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j]:
+			diff = append(diff, "  "+oldLines[i])
+			i++
+			j++
+		case i < len(oldLines) && (j == len(newLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			diff = append(diff, TextColor("- "+oldLines[i], color.FgRed))
+			i++
+		default:
+			diff = append(diff, TextColor("+ "+newLines[j], color.FgGreen))
+			j++
+		}
+	}
+
+	return strings.Join(diff, "\n")
+}
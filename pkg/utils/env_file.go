@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// parseEnvFileValue parses the value of a line in an env file. Double quoted values
+// support escapes, single quoted values are taken as is, and unquoted values can
+// have a trailing comment.
+func parseEnvFileValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	switch value[0] {
+	case '"':
+		closingQuote := strings.LastIndex(value, "\"")
+		if closingQuote == 0 {
+			return "", fmt.Errorf("missing closing quote")
+		}
+		return strconv.Unquote(value[:closingQuote+1])
+	case '\'':
+		closingQuote := strings.LastIndex(value, "'")
+		if closingQuote == 0 {
+			return "", fmt.Errorf("missing closing quote")
+		}
+		return value[1:closingQuote], nil
+	}
+
+	if commentIndex := strings.Index(value, " #"); commentIndex != -1 {
+		value = value[:commentIndex]
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+// ReadEnvFile reads the KEY=VALUE pairs out of an env file.
+func ReadEnvFile(filePath string) (map[string]string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	variables := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		// Skip empty lines and comments.
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		parts := strings.SplitN(line, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, fmt.Errorf("Error reading %s line %d: expected KEY=VALUE", filePath, lineNumber)
+		}
+
+		value, err := parseEnvFileValue(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Error reading %s line %d: %v", filePath, lineNumber, err)
+		}
+		variables[key] = value
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Error reading %s: %v", filePath, err)
+	}
+
+	return variables, nil
+}
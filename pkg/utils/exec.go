@@ -11,7 +11,7 @@ type ProgramCloseCallback func()
 
 // ListenForProgramClose sets a listener for program exits so we can exit gracefully.
 func ListenForProgramClose(cb func()) {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-c
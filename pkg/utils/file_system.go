@@ -202,6 +202,38 @@ func WriteNewFile(pathName string, fileName string, content string) error {
 	return nil
 }
 
+// WriteFileAtomically writes a file by writing a temporary file next to it and moving
+// it into place, so the file is never left half written. An existing file keeps its
+// permissions.
+func WriteFileAtomically(filePath string, contents []byte) error {
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(filePath); err == nil {
+		mode = info.Mode()
+	}
+
+	tmpFile, err := ioutil.TempFile(path.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmpFile.Write(contents)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpFile.Name(), mode)
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), filePath)
+}
+
 // CopyPackagedDirectory copies a directory packaged in the binary and its contents to a new location.
 func CopyPackagedDirectory(oldDirPath, newDirPath string, exclustionList []string) error {
 	// Read the directory.
@@ -405,3 +437,17 @@ func CreateNewDirectory(name string) error {
 
 	return nil
 }
+
+// CreateDirectoryPath creates a directory in the current working directory along
+// with any of its parents that don't exist yet.
+func CreateDirectoryPath(name string) error {
+	// Get the current working directory.
+	pathName, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	// Create the directories.
+	dirPath := path.Join(pathName, name)
+	return os.MkdirAll(dirPath, os.ModePerm)
+}
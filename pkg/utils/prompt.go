@@ -56,3 +56,23 @@ func PromptSecretString(label string) (string, error) {
 	// Return the result.
 	return result, nil
 }
+
+// PromptConfirm asks the user to confirm an action with y or n.
+func PromptConfirm(label string) (bool, error) {
+	// Create the prompt.
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+
+	// Run the prompt. Answering no is returned as an abort error.
+	_, err := prompt.Run()
+	if err == promptui.ErrAbort {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"go/format"
 	"io/ioutil"
 	"os"
 	"text/template"
@@ -45,3 +46,18 @@ func WriteOutTemplateToFile(templatePath string, filePath string, args interface
 
 	return nil
 }
+
+// FormatGoFile formats a Go file the way gofmt does.
+func FormatGoFile(filePath string) error {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	formattedContents, err := format.Source(contents)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, formattedContents, 0644)
+}
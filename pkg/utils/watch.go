@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// watchedFileState is the state of a file the last time it was checked.
+type watchedFileState struct {
+	ModTime time.Time
+	Size    int64
+	IsDir   bool
+}
+
+// FileWatcher polls a set of paths for changes.
+type FileWatcher struct {
+	Paths         []string
+	ExclusionList []string
+	Interval      time.Duration
+
+	snapshot map[string]watchedFileState
+}
+
+// isExcluded checks if a file name is in the exclusion list.
+func (w *FileWatcher) isExcluded(name string) bool {
+	for _, exclusionName := range w.ExclusionList {
+		if name == exclusionName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// takeSnapshot records the state of every file under the watched paths.
+func (w *FileWatcher) takeSnapshot() map[string]watchedFileState {
+	snapshot := make(map[string]watchedFileState)
+	for _, watchedPath := range w.Paths {
+		filepath.Walk(watchedPath, func(filePath string, info os.FileInfo, err error) error {
+			// Files can disappear while we walk so skip anything we can't read.
+			if err != nil {
+				return nil
+			}
+
+			if filePath != watchedPath && w.isExcluded(info.Name()) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			snapshot[filePath] = watchedFileState{
+				ModTime: info.ModTime(),
+				Size:    info.Size(),
+				IsDir:   info.IsDir(),
+			}
+			return nil
+		})
+	}
+
+	return snapshot
+}
+
+// diffSnapshots returns the paths that were added, removed, or changed
+// between two snapshots.
+func diffSnapshots(oldSnapshot, newSnapshot map[string]watchedFileState) []string {
+	var changedPaths []string
+	for filePath, newState := range newSnapshot {
+		oldState, ok := oldSnapshot[filePath]
+		if !ok || oldState != newState {
+			changedPaths = append(changedPaths, filePath)
+		}
+	}
+
+	for filePath := range oldSnapshot {
+		if _, ok := newSnapshot[filePath]; !ok {
+			changedPaths = append(changedPaths, filePath)
+		}
+	}
+
+	return changedPaths
+}
+
+// Watch polls the paths and calls the callback with the changed paths. Changes are
+// collected until the files stop changing so a burst of writes only triggers the
+// callback once. Watch blocks forever.
+func (w *FileWatcher) Watch(cb func(changedPaths []string)) {
+	if w.Interval == 0 {
+		w.Interval = 500 * time.Millisecond
+	}
+	w.snapshot = w.takeSnapshot()
+
+	pending := make(map[string]bool)
+	for {
+		time.Sleep(w.Interval)
+
+		newSnapshot := w.takeSnapshot()
+		changedPaths := diffSnapshots(w.snapshot, newSnapshot)
+		w.snapshot = newSnapshot
+
+		for _, changedPath := range changedPaths {
+			pending[changedPath] = true
+		}
+
+		// Wait for the files to settle before reporting the changes.
+		if len(changedPaths) > 0 || len(pending) == 0 {
+			continue
+		}
+
+		var pendingPaths []string
+		for pendingPath := range pending {
+			pendingPaths = append(pendingPaths, pendingPath)
+		}
+		sort.Strings(pendingPaths)
+		pending = make(map[string]bool)
+
+		cb(pendingPaths)
+	}
+}
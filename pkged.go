@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecfd598fa3cad2280cff95a3baddbd96194c75b9a573e1098c6d709bd1e4d1a32da6624a8636788047fbbf7fca046cec72b9aa7af5d6f75e584bbdca909173644c1911fcef5390bca6f9d38fff7df282c2df597fdb69dcab6cdfccdd5e5eb8fbc045659360fbf4e3a9a7e6ee36ef55a61dd5005edacbb776efdd9adf9ef8384bb7c54fb3f09f7ebcdfc3b727d18cdda71f4fb119244fdf9e26a9fdf4e3e9e9db93626e3db778a76b2b48ce0d4869fa0edcd3b727c12c6cffe9c7ff7bfafbe97fbe3dc98509dda71fc576e7360f926be669f2f4e329474fffc771333771dcc42e7ffc9fce98ad9de76e7b5e5ab8dbf8e9db1397b2017473d42c1af6dfb8a72cf25c07fdfc9f76ee18a0d34a6c6e23cb2cdcbc8760b74fdfee14a2ff0789d78bddf812ee62f97a76eca0fe266e863b33b7b61fecdd5e61a2d6dba72ac89ebe3d59bbd7008dd32a0b377ffaf664a771b675f3bc67554146755fbc42b370bb2fbcba85d37305030b3f27851924eeb60783bc685eb847fc6b5b66457afad133eb2eeb073bc87c3cfde6d9e9163ab9797e70edcb4787621872f0e6452f480a779b98b0e73a0773ebe4d76010065911d8e7377e6c769e4ed5b766e2ec8a00de28ca775601dd7341ec30e70754aff364f73b0fdd09e4be495e3c51ccf3c53343529de7ab2e0bd859a723430c2e9fce638d4d3bbd02ed6551707cfaf6e49885696104fa057bce36d8e38d70133b7582c4ebfcec997942769f51ade7fec59b2031b765f78d9defbb8f5e6a751f7df7d87d0cd1c1eb3c6718d5dded36dda2c1bf42138de7352e2e0f8005cdc4ebe56ebcbf3e4257a7b45362fbaee799a6d9cbaceb82aa74b7bdad6b3a3048dccb4227f4ddad15e4bd2288318a76cadc38d866661ed83d2f75f2dee928dc0743e7e413103d73bb35cbe650bd0f5b6cdd0f8685219a7df25d33bb0b8c10ffaab957b308fc9e6dc62eb4cddcbd5998c2f46a1fbcd4dabdbe9a30edf9eed6bd2e7bdd220a93dad1ad82dd2e70aedf7b692fdba6056ab48729d8bba5f8c7350046170fa6decd824fd4bd04b1fff2dce42f0f532d7b1b64c5dbd95fd52acaec7a9b6ec3f4cca4fc149cb3db9a45804fcfc7c06e9c159f6b362fb63bbbf814283e1185195f6194b7cdecbf5c3bcdcbbc709bc7347393626bda3541e8a5057a7b59cd37733fb0d36dd673b7dbc3f61a4fcfc55efa57bc83458089c425506826ae5d453b04936741925c5387d07213b740c50d9b7a834ce12edcf5cc240fde12908ba25e615a872dfa790914b9fb20b176dbc8ede5b9ff6f3b4d5e832bac83bb24385aa9ede7bd7d6143d74caea58024784da16363ac8eb362177c08d07b4b286e41e5f6d675136bf77a57eeb82f94bc3dcfd7101d1e94e59f91703e2505b9b1e53abf272fbd0397174efa667c4591d4f801d3ad69c137536dca83dc2cae8fd4a970bb4bdc43e014fe557950d8be0ba18f60fc34769de06aadbbe7c44254193db8dbbfbcf4b370bd4370bd3d5de0ceefbb8d5ec2f56a81ee73b06fa86c1679bd1347efbedfc15d1c347ffe320f792f77a2de9e4614c23c7c0db86766816716eec12cbf562f30e3af5580666c39e69d3a353c85e0ed348ed3048d0d51cc2fd5b1d3242fcca4f852252730afd7fee30a35f7beb7dc6feb7564c5cf57daba79badbda5f5b87b652ef1625fd6cdd0ceebc20f952dd228ddce46b8b8264a75ea36f7dbda21d344ac757ebc5ce6f564cf1a9fd1a86e19aaff9eff5e805c5ef55f48b22fbbd9a30f5de3298cf548ccd2cbbe6849fa9b775f31dfc8d35ddbac5f61ee97aaf5a66ffdec23415d1df1b82d4072decdd6dfe46f4fca0ce21dd4679667ef6f8d72f3e077bec99bb6bb9fd3e6c2fcd0ac7cd8b6dfac9353f57cbb6ee3e700f5fadb6755fb76eee7fb5da2efb448d2475dc30ef25d93d56d6026329be77cdfb6f8396857fbdcbb969f9c1d6444ac15f5e50045e925ecb1bb9bbf50254ee04afaf3df4bfd82c6c3fc356b84bc8ec95a47b766a6dcd5b0559a3fe770bb6f65f4ecfb35fdf7ddfcb6df3ade0df29c794fdfdd2b78a1ad2130adf350b6c5840aad05fd0ddbb49ee176e90f43abfafea1d5c378a111f77b73db8cbafc7b4b3dc6d2f345dcfddfe65c3c04d8a3722d94d90b37c6d999e677aee97ea6cdd2cdd16ee362fcc6b33c40735f3cc4cde08771fd429fc6d5a14f053537f2b39de062bfc6df05a7c1e1229eb3dd373af85a90febd4655fac949b7106dfb09c0fab5541160589fde630bd53716b2639dac7a6de67eadc30ed74016160f562b7d806f615d0d14caa1229b37fdd58c3b796e83bc5481130b30c06f60dcbc50dd05d91fefb161f780b7a9a5bfa379ad3dfe9d6eb99451a63736f7d66f1bb63af31875a303dbc06353dbe516c9b79c1bc57e69bb66f52c47bc5bbedde6d6dcfb700ce06ec5ba56f6dcdb7a090ca9579d9fdd29eb98d6bc67e07c6859e199bf023a893fa76072833edc82d3e00caa9e81d88cc8a9c57eabdc21496244dbcb727f9bb3b99e7fef9ecbf537ea6a7f8e5bff148eec047497a48fcb431e3be0354b8db3848deae6ce216bdf31dc99b2224eae2ff79bbb7cdb7006f56e954d0f3d12edc2a0e9cc4bcf9fe8470a91dddecf14ccc83d8cddd6de0de04cbb6e9b1bc55806d13d7057999f77609be8eb8788f8d72b96befb66ecf0a9c60bb7b7b08300c2681af696da47b53ba4b023b75ea26ee02246d0ba907ddbf3b709e9bb4a2122a32b32047c2720ff1cd5d7ebb4a63d4bc59d0338b621b58bbc27dbf72cf32ed287d7dbd0700cdc4ae99d27d087c57f309b06dba4b9c6d6ad54ce41d607c7900d3faf1dfa7c77fefc9f72ba1e5bd33553b4d12d72e827d509bd1de81daba8e9b148109ef3575063a21ebfbd05ddbc57d88f37dc06d38f4bf4666b90df0f1603aa2dc073bdf81ac770ea6a6f319f076b33e03bb7b7dbd875b2740db473236ac3e01ea26fbb3e9e603d84f2f287e6a2e5c3f039a97c99d937901daaad31f806edd3c857b77db73923b98f9163c33f31c09c53bcfff44b5bccc6d137e663c2789f07dd8c875331336c6a9db20b15b988e5998ef4324667cf7ec64ee3d04dabaaf101dfaf40eb139c3d4d4e6fcfcef3d69c2ccbf33ba7699df87c8dded3eb0dd8f50f2a41dbd5f7c8709f48afafa2acd22efef2069f5cbf42f2b80b0fc7bdfbf5bd8f35d98b9db9eed6fd3b4f81428128f5e830651de054ef3d7fc3ec409f9af21bca0786fd87551c73efb1e44e730ec83d67ef421f06e7b6f44bd0cee62abc5c80f607ab669fbeea7205f03e8c6a9f349e0741b9bc52756e0ba86133414ff93f0170697cf560a9c239acd97aa248e7bfc4a85d40abfda079253bf5c272a5a0f8d0faba456e8dab74fcf35687da79f423ca6db07f77e959e6d66a615c05694f962ed3c705cab65661fd5ddbafbf6aef743d8bc48b70d31fc08f4927f7c1aba57db17be58e9d3fb7eaee2055fed04e9435fac72966fb0cdfa8bb5116bf9ea6a9f34d7776aa01d6c2c7c1f80e045ad7d2ebe06dd73d2e283d56d2bc56e9c6eefa23836c49c9dc23e80fb88fed55041fa015b6ae062771b41b7d8067797eb1af693ebf6a6da8734f2468d06bb5eb766fcb53126a973895907739b048997ffbd27baaf4b33867fefa9a76f4fbe89110bfde9990e74b774fbb6676fedfaa18861f3a757b871d6787c06718d70f8efc9b5ab7e3a4d013f36db521785998b78df5997280bd78417afec6cd77d7c8d8bbc2638a757895bb4d682d3bb346f2fa84eafb2148b3a1d97cdcb2a8dc80883e2e2751e241e745f61e0f917bd365276cf3dbab69bec6f1535a68ab3b8ede645ada99c059524375f5ddf35ebadc28e45417ac6de1a3c36b15708fad3b302effc138b9bf877a3d7c4014612f4a7871d9e32132f167ef16b9716ae936d83a4689c54126c7a6b0d419d9ff87fedaa5ebc6c06767ad75157b1ddc63d16ad068c9e6b490c7bcca4395eacfa571e7875a534ef35b71c593d4bf4079fafe6b9d917fccb738fd9e9472f2f93c2442bbcdd25453df1e657cfc69722edd369b94fe6dd3725cd12bf799f9779e775966dd3d7ce73bb420d4ad69e03ded6ccfcfa8ae8fcdc33b3cca98fd727a0cefd379e399fa9d371a941358aad9d62bccc8b2d3af5e857add1a23fe7a538ab8bb589ace3a256bf389ff28be75e666eb1b1a859fac63876fed5db15afe4f3e5f30b7e4438fff4ed69ef264eda5e8fbd6b2fff0454c7d07b0f1aff4124e6b370ad2bf33de0aee5fe0e9c5f5b87ef40bc35e2df01fe60c6e8e439498efec56e9ed7b4f93dc06bebf18770add1f61ee0d9a8fc1e54635b7eaf789beededda8bccc1bbe70abf41d6bf0bba05da3f0bb4057b6e10fe1b089f87fbe3d296e5e9ca23e921d84f5ab539c47fd4a481d34c81ffffbf471d88b6006491b89f2db31365c2aa4ce6f54ed79e9df71eae016b4c6a1e3c713f937493ffde73ffff9f6f45a4ff4fdc8a01f3d18580820485e53f4d7710b3380b84e5207f4d431227950b94f3f06cfdf9eb04afd8322fbdffb2f7db24fe237ffc654e7c7134550c45f24f517492a24f583a67f50cf7f3f33cc77a64f31fdbf88971f04a22141fe6f072d55bd6a88a6fff8dfa789bb7ffa413e7ffffe9da208bc072e7a7ef9fefddb930883247afa417f7be293f4e907fd42d32fc4f7feb72735709e7e3004f9ed8943bf5045c9410d11df9e7e9acebf6d2ffd37f1f4e3ff11dff07ffff3ed69886f4032d7467dcae80ff94c7cff4ebebc10cfdf9ec41cbd79a1e9ef2f044390fff9f6247c02fe34c3ff7c7b1a7f117e146c0bff73759e5fc81782facfb727f9b41923886f7cd0744730aa37a94fa012169a5e5dc0b909febbcc3373ebe29febe6275a97ffc198e2bbdb4794d8edf8934794d8234aec1125f688127b44893da2c41e51625de84794d8234aec1125f688123b79a03fa2c41e51628f28b14794d8234aec1125f68812fb52b54794d8d3234aec1125f688127b4489dd037d44893da2c41e51628f28b14794d8234aec1125f688127b44893da2c41e51628f28b14794d8234aec1125f688127b44893da2c41e51628f28b14794d8234aec1125f688127b44893da2c41e516277a2c41a128e6611799f8addc12163ffa9830bdab5c8cc2d520e4ead9ca17117ef358ac3cfea9b78ec5d7d3f12ad03d706a45154ffb722d2be5383c1f3cbe01f47a47dbf8c4863fe5b1169dfc917e2e57b9fa03f1d91d6ccf0d3116967f82f44a47def33cfdf9f4f116978371e21698f90b44748da2324ed1192d6297984a43d42d21e21691f413e42d21e2169f785924748da2324ed1192f608497b84a43d42d2be56ef1192f608497b84a47da9da2324ede91192f608497b84a43d42d2ee813e42d21e21698f90b44748da2324ed1192f608497b84a43d42d21e21698f90b44748da2324ed1192f608497b84a43d42d21e21698f90b44748da2324ed1192f608497b84a43d42d21e21698f90b43f1e92d60d23fbef44a7d562d7df457e3f4ced04750a5223e93648ad4f119ff95eda80792118e64d74daab09f30fc2d3689a7c793985a79197e169cf7f303c8d625e28f2f4c1b497179aa4fb2f9fff5e5a3dc3cf7f2fed04ff85e8b41772d01f30e7e834b40d6d74dacbff5fa3d37a75fcd24584da1929ebc2b78168e750b3735c598de04d5859831f977165dd70b11afa8a68d4b15b679af220339f2433677a70a2374fa64e1e2c7a4ef0e3d43336826750839d339bef2dee08ed684e9afa31021bde03c97c6fc9c360190c2a3b16f617707010383acc01a7957c982faedbb4b84168e887376d34ef83d521cbaccd686f276b6f118c7cbb1c556033a7c0663eb263a7e239505a14e1f1417f6fd3f370498bd04e00ead7c7e561be18c75adfe606a5331e7d1f07438f1f8f428b62083b1ee416a795eb44cb2c4e82767058f0e3a1c773c7bd41b1f972987ae8d9a6b4d289b572bd9927767958bc2af9e2e9cf51622413989efb370ee1ba4b8d2f205b8afcdc27be46919fc9ef038678fee314f9fb1f0e18fede923f9aa6bef7fb14f9fc6992dc4cf1d324f90cff7992fc4cd08317ba7f22c9781f1e24f94192ff1849be38ee67b2ec1e10599aef0c9d847c987a7c2212600332a00d488783b95df2392673880c26626651fd60150c0341ee1f96e130a8c9da3c021b31b463787038b8b702fe991fcf473c2762b26dd012aae75994e1999c96db9c56dae528b668de5369093a31843cc71e6cee9819144b98fa6077ea37267d53affbb438182c29b8039cd65fc659c5d724367066f00036c20d98f5ce9909ed182b839e67f64caad03ceb79a3f66bd653cf5daa8c72582cf5e6dd4caa10fb01314c507f68ee2d19e793d1de46f34a44c2d08ff967eba339bdcaf5b88d8d4698dca0c4758376be680db49d4da9783eb2264eda79024e3b009d8980ce84a6ae5dcd83a5ed92cc0d9d0c0037c57537e174278cfb14dfb2a2f13cb02889596e46be9d88d0aef7c915e4fe7125f70f1d38e8ce4625d888b80f1ef6896538a597e1f43cff98dcdbb1e35b9d368471ffc07ba9c7cf88662e12742209da9c86f663675010da25ffcccff2b69f61b3777b63e3544bfde85bfa3c32e4bacdd518b74989e3666dc6c3603d93189bd3aa25a5f5edd91cdab4d0cc75bd13147bb7926fc1be192bb30cf9f35ca211e16e46d02e073b8b926033e7a338aeff099dfeebf136b83eaedb4330a83dbcb7eb6c30f6feeffffd832c3ddfdaf7393902681938f94cfc56de8fe797effdeff43fff123573c9c65ffe8b793f48f28521bf7f9e8dd733fc3c1b3fc17f818d53fd17fa9938b171bc1b8fbc1f8fbc1f8fbc1f8fbc1f8fbc1f9d9247de8f47de8f47de8f8f201f793f1e793fee0b258fbc1f8fbc1f8fbc1f8fbc1f8fbc1f8fbc1f5fabf7c8fbf1c8fbf1c8fbf1a56a8fbc1f4f8fbc1f8fbc1f8fbc1f8fbc1ff7401f793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793f1e793ffeabd19ff9d6fe6fe5fe40b3c2de4b2984eef6dfd62e808ebbfd3019c8ed2a6d181b430c065f0c446788ef34fdc7e3d007ffcd387486a128e6f3016c7886c4e703d84ef05f0860a3c9e7fe77ea14c056ef431bc1463e3f22d11f91e87f8016bd43306e640b99e5de5a1bcd258a25c0c6f15d6d545ab4cf481b00ad649df353762dab8eef701a6d6ce0da8e077d579b439bd672673c1a9c327a5c8747773281b8e548396701b1739e6db27e446c09884160c65a78d156d0dfb7593c36145b025a08568774b12cd35fe360f88b9fd5e1d552a2edead0776664c70e69e9dace99099ecdb1a54d69153f9352208ffc6e9945199e218f624767c23a947ee45bf17ac18f53cf8e35c2d9cc777639f2f99948d831dc8112f535f75d79b407c1883039d53336f384e718dfd2b56a198c54fc8ed64a3bd676ce7884e69d039d845622f9b80f2ff516c1686fc547869f89a43d43eb2255cd3c322bb63d709e4bc5cf9cd2dc4804d0fb9e4341c21c0f939f4a3fe1672283da74c77d345e3c662d6673535f3fe375190f71d6179b5e77daeb7b3fe5618ac6bc0a4695339ba3fe327e42fc8b9f1583d521f516787c430fa713484482e70681190c0775187913c2cd91d0e1fc3d98a4de8243fb86e6af3e9fd729f27e2a7dcfa589efed585e95fc344e596728433f666092366d5eec014e8f00a680b46211a727c07d54a967d35269ea4cb29047dfbbedadd1dac56cc173a88ee0699c0febdf757d7e06f78e3ca20d9d21f899b46f71e6164ed4d96cf09aee3bd967bae33b189b7905d473fbebcd3c71ea3dae16f5189ef9eef895f3d8759268d208bcec7976ce4a8466f01cd8dbc128039cb6733818bb3ac6b5137ed925c637ff624e1cdcf1dcf4d4eed24bcf7b3665658914d05eef37f0582d60e16ec6beb380877433137e2d58f40c9d4500ed8576ac7e56c4bf9614f17decdd1c576573c7cc994957b8d96fc686d60a67e5a99cf168a446ceab4a8e04595be3fe97e397f7daad71e0726d7d3bb63d2b660bb01176e3e08cc36b6d3ee32784b72419db2ed37453bd640bf6a82caa976c3361d1bb6859e50b546759be786aa4cd24cdf3403c281bbc3cd81c5ed7d0a2c802e80c7135764563e773951d4c148214259551d57238e0c72fe9823dec37e397dd225867af63df5e92875f3a79ac36ace82cc281bd8087fd428ef6966e9fe73a85718b8fe64640746667d1eb6b7a83f7b8a5353cc7ee784e2bec99c4f0886ec52c53ef2b8cf9b18fd326209a85f7a01cfa3f15446b3b381144a71415a736d9c241e79a9f11e7b54138b3197a0e3e27e2de6a7017f50fc61d3c94efe3e005ce9de880569d698b636de4e1e03486132c6e43051b9f407b5acf87212cb2a51f5eb64ce6d0e68ebe41a9a94a4ab2ac8d5ed7c44054546da5b142ce8fed648169c069ffd039168d8d149a9c569dda2525e8ced63b4b670983f25284476a1979afb383a793c4e57e217ab099433c17b45fe5a8ce44d59c359068a195d4b88fd6ce0a4625d848a41df73d43ef7b40270fce2c42eb551a9b08e3afa91b0d8d247d87135384d716a7ed002da5fce4e0099d3de3c7e7f6f0d8708a0b44e386fb6539122c6a704063b4e3010928afd9bfa65d7dddee13e2619e3dd32a737ccda354cfd419c26ae94f4dff4ab011496b86f7bf3474b674e5feed751d0f07fcc55c444bd0889d4d8f7273b3c66bbb21c5b532856ab33738edcc823b42c40bd19e0893dc33e5e14bb79d657c8456ec10262ecbb788be77b27d3563b0bd9f72672c6c916d9426b5096aab644ab019e5862ec205a27fe501ad7b6e4d52cfd01966391e91562c413b11438b9ec315e2f5499437ebf296779df704f5b9423cc92e9983b311d3455d275bddd837cc5b9bf7af72d4d9c34b18a5a671dfc709c641b4bfb2b171648bd608a0fabe154b39d8f09876d4fb7c7ee7d073448f11fe05ed19c5697e28c417c412e82c817842b3bfd08aa58345c19d33ee2fc0c6af653d0eee1c4e2b41cc8640bedd77bb068bd91cda55eaa9b156d9dc6067531a6fe84c64715ab91c0f77cb80c1e7641574653be7a71167d0a0256b2347cf3c3b2a2d8acc6c4a7db92563a2b61dfd48fc0cf3055a2f4ca7e201e970e7be780eb6725c7a7e8779896f05a3913a8dbc53bbf28875b00cd65f209c079b798c708367e77b67234119d13aee08eda0c5b5610a6a39f7f9a69cab6a14e2198897abb3f9ded2610594e3489dc299b191a043b10c7aefcea05c9fa7f5bf56876cd0ee2dd8880784cbddf5c5d9f63836b392768f1bb90ac921886f357b8ef712f106342e7974ea8fc729794688a6c2a597417756cbd857f33dc1bc9df3a8023a132d38249b4e9f511f2edaab7b72fea439733311e1d5c6dc48a94a4bbe33d3aa35358880ec871625a1f3b57390fcbfe173fe8bedb7fb6fd07388e6aac6dad1d1b5cae9cc05e302373da5b8ba948d304e8476ac21991bcbedfc0cf81607a3db6b88f497fee242b6b9d3f7622612063acb84b8079cfacc730c69e973680707cf880791db91f710bedcc3f7537aa6d91c3a33adb482d177feb2fd9ce7e67b3043b4ca87868e6898d049ebd4a45fc2b884f09d54919cd0a4c842bc6f8ff8a8a977d269d5e9a0460625429b168a759bf5714c8e2ceeb8774af2a71dc3a44d05b668d3658d47032477dfd89f962e34677eddca3c29d08f873ad3e4796d6bdceed0296eea39b5ee82d78a9fb6b8112139a65ea7461f34642c3792608dcf1561722cc1730c043ad2a1a43dcfcde18996533036752dea9e8d77f6f87afca9817059493d2bd10a2346746e14191bc96ff846b6c2f4e6ddb3ff560e6be60b109e6f3468d3d29926346766391e2d5462b05a26e7f1bbb28ffb5d205e9220190ef16da73436a32b7add66d5accfc59bf5aeeb1c3a7ca1916d06f10d9ae863bdb7ab9b975dded1d5adde1f0b967b68e1cc5bc9c2598e47382d5a671ddfe1196f6480464e593fdfb419b08583e48bb34c37ace73df673b059239963c04f86df792ef27ed63cbd7a5feea8ffb5ba07e2d732a531a87d2c63911dd9637c31c64e86532caf746d1d69db4ebb063a85c65f4057233ab2444d0b6a9ad4c81bb3ee3e44edba0cda799e706c764943cffbd5c8ddb31bbc20bccdd7d15c4f384c69bb5ad7bfa0e3584eba778684707868cfcd423edce71b0a6aff2d3d051c4b184a2dc32de4e1e0e789ae6b1d9edada8d4ee7e8d4674bab1c7aeedb6ff13cc378fe9677d076ccb6faa2cfcfea7a173218b6c5d4ba24a625940f0daaa8f0f9acf528bcc75d1e5edb87d0f9f4a1351b21793b407cc2d0b1fe84e99d218f02a41f9a63ac6f051605d1793a581c4b0019e9555a0c8277cee299c65ccdd33fc94babe0a6ac87cee1bbf25f473fad5c9d09f9b10fb16c35b9cddb1a7b216a13f1f6c2dc88f0e75d9edfbf92b9878d4ed68ca591711bf9ace6b15847399d3f7cc6ebf7c3416bcb3a95d7fa733de63193d9c4dcb7299512a7da0ed3797d9d5df1d3fafcb7ed71b5ecf7b364ea3d9dbc7832147f2a0153db82647fece820061b7e57db8f4e7dd52920c7c3c088d90a2806c107d1cee1064a637b4b1772e4ad82d69e759a0fea33b729b5d61febd48a081f9a757fd9b563c33680965f94fcfd794c334521fabbda26aab6f26ed3567441fb1abdb37d46bc7604b8752da7ce58126ce60cdfd8ddec724458adbdeaeddeb4b411af8586ce0ddbd49f109e42cd7f015d2496315bd9144cacc0fffe2a3772947c68f77c877966029b7573d6c666ee5bba86e4dc0ae99addb163dda19e632357633e84edbdce956db0b5d98218962dadaff57dcd3728cf33db33e45de1e64c4c2d5acbd1393fe127e6b398b762b9a5a1871eb6a1d6766dac0f376d627b5abd9e5d39be8bb7adbde48ac74f88d6aecd9d6c498837d05a63d73a9ff7c56d3ed5d03736b03afa559d251be1fb657fcb44cc4da4574fa6f99bb296878ea313df3dad118b6483e1c51ed46b54cbe2c6a6b1a5209c406b7ea5dbdd5c8b13cea1737e47f668e41d439fe7d765cb1bb8780b87b419c2f16b7d13d3f6b32e2f0f496132f4c0665ea2bdad655bc41f47d04ee67b3ba9ed986dbb0e6ef3ed19edd058dad06167cff9d47e5fef3ee073b011aee407c233286ceff2169c7a9b2620d9861ae44066728bb2537075feaf74923b3cd743f3cff959ee5954fecc73c0b76622e2c105d88895a13b481784cb9884362dfa80524f76910eadf1da3b82d775fa87d3b9f6606a9bf0c32bf213d4e97b1904f5f2c55bf1ef03aaff42fee96bf117e2bf792dfe4cf45fbe702d5e4ff1f3d7e227f82f5c8b332f7d9a79397f31036fc4233ffbe356fc8fde8a9f0efce72fc2cf461be2f4d98bab5cdadfc7312c9a4bc3535eefe578f41d1be2b0e2bfcebb46b96e5b48a8c7c4beecb633fc8504efda0804d758e0ec7cbaa31694c843f3fee2b2ddd20725a0d8ddf962fe949bfbfbd8eb5cca37cc438d59fadae8baac15ef6be365fec1c57b7e8f392f87d9c505ffbdcf8e8ce33fa3e0dc30b4f9360d774639440cf688ea810d120644a29eb3f0dcdda746f9fc7e2d2448f4dc37ce1779b5b1b011306e0b73d898e7fd9447ada2bc33366265eace6e312b0675dfd35d2b00a33697c9fb42ca43d07d08ba1f09ba37f126d1de8ca336a03ad0199ff0b9d95bb536c4dc50ba3bfb3f6d0ca4f70ca3ddfa849d44574a7f7b99d6d21b6c70c2177f0ec566cef83cd6c52d635670f09641ade01a3a592dde28d522be70e4c73ebc36f675d67e6f71eacee20609129e579f1bdf2d1ab9c38633d6c91cce571c8e259c8d38b128092e26ea41181f3cb71cedcd2af540cc22653fe7b99390fccca331e85a65536c02e4d1e07a2e6f71f044d73eb37788beedecc600d75d4b09c332d5c5782ffbaeeba2b98dfdc6605df3821bedd4f8279f8d96f5a5d83b9755d3faf34d6ae3f055f322f56c88e258c29c9c2f57dabdb174fc6d8f68150c034069041f8c5ef8f1fce06cd6013f3b34df0f697ec712b4388d008857d763fa8877d486f7dbce40ad62245894e85bad613af04bdc06e22137e7a4d597a2dce9bb1abbf56674b03818b6b4a29d333aabebc6c87a32dccf46884ed50e6541b3af67478c838d2fea4f6da79df3bf5f6207bcd690d38eb7bda899572d2d423465996815907dbc87359fd1cae6db2a69674f7d7b36dc391b112e2e65949db399e74067b051168cfdef684c4efd7d926815a0f3af21f9275b9c2ff20ff698216d4a4d5bd96581bf776304b8bf8f0dfbedba9f9c2c56e5285b94f995127cbe54c0f0d86858cb14378cfb68bd2dbbc6c55d8b6b1bb9d9631929e152ba1c9f95e42bfc49dbba271e1b4427ba7232b475e592d910e3dc1f5684eb28cd7f9b5b2fff501dbe826d95629a79a1bea6147f2788c177eacf2bc5e47feb2b9264bfff9d7e1930834f2bc5cd143fad149fe1bfa014bf3c3f532fe76f96d51bf1508a1f4af11f558aaf8efd5935c6aa0e62f99b79661035abb1132d74b841e397a355405fb7aa0163736a7b2786c57f833afa362d42bb7b8fdcc0bdd7ee4f76fd2fa402489dbbec39f68f40645bdae3cf4329a9a761b1a2e313ce89be4d4bb51f04f68d13b7f6c95760ea010a52d89f3a667347575b5f85c6bf11b144c937f57e33666cbfaddb9d8e6a7bab5c7fc28a67313bae7f5ffb1837fdf21c9b183adcd9f47a77d546fd19b8ba8dfa3737881b161f5a1c5bd9e588b168ecbf072f5489d8c6eb7cbe4fbc5a7feeb8079483e0aefbac7d27e45a15746216fdc67d3814bb4362bf55367eb9f8bef1ae1f74686c4442c37b70547f063e9e43c7af3cc7639e69675fbb7abf9ff9a903ad582b4d1d89970c82270d5adadb216a277ae6d9f5d9477adaace34c4a8d93d852b78bc4097323b67ebc19088669e7aeac996b7b4786c58ed8d41908c67cc6cf8e2ffcd8bf80b1122db7c651c754807d2d699e63a0538e586ccf0f7c4fd1616553f86ef870be87e23dbd94bed7becba4b38c87d995ffe6c4e4b4d06cf1b0dee7763ff03db4158cf6ad49a0ebfb6c51f35f6b7c9672a46a9cfc805bfcfda910037e3c6feb06b5af2d8cf9b1e777ea5e88400e87c417dbb3628de659bc66a7f9491bff00749140f8d4f6e1702f9e11abd8cfd56aeeec78368f5c0afbfc0e3632e3353e9f83b34fed8dbd3b9b08d09c9b4ffa3121e8c454808d4fd81ca2015ad4e0ccd997763c4d17a7fd672b7edab617215502a92d3b83c2fb8fd769215ff8d7122612f3cad10e6c6c0fe3aeaa95763c2817dc88756723ecbbd4ceb9bb9f67dfa29a66a1b2faf374f55ebff1b13ef9649cf6b93e53e735c5733addfb4c8eeacfa4392f67bf414cc3d61bb1029b3936e3a88dead3f8281317f1265c37a664ed359ffbeb9883d80ac7d2b0f51c3a3e42bb7152bfabd7a48143e71cb677cb438463edd96e54a9d64f83c73eac8ba08ec7309af1e2b6e45188f19ec63e8ebe336e7cf1f0fec1daffa84b93719dfedbd88efa5cebf8bc68a7781c4cc75a75f0dcee898e36b459da77e827bc4393afe9e309f76bff34a4eee37dfbb53cbce353d69d7b4d17f11834dc96d6defb3f37676e847922f629679413dd7ce3937c68c772f6a1fcff0a8dbca625d3d379124d6cd644fb52ef2140ea5530b2e6b302f38a578dd819e3e89266b09d7dba399f06671adfe6facc22f5b2deeb8b9884a64e8d8748c5bb9a774daff09ad4ebabd532c3783ab88d6fff655aa2d5e36dcff8ab467846a2113ce7ec9d315eb77adc1a1edb5b9a7b5221872fda8439cdf1e44fddf91ab6428f303d31f4a30836526a515255af1b927d98aa7b7edacfc7a27d68fc9b284387393639caa3bd3dc3f8eb5bf8ceb76debb4475dffbe6a1c5ff89d5ff8fe9dda999efda0dbf53628b67226e7f3b40a4633a033d08e61b8c67c522a1d5d242c9a7fd126fd6c158c5444a31cfc09579059b116b52af7c937771c75ced8e8859f75f0bff573e6e0ce197b59c79fd97b6d620900aaa7afcf7e99b76319f117cb11bdb9e0f99b53fc6163a246bcee648e29dbab8866bdaffc2fdf5fc3d6ffb65d378c73ca5b93d9053d39995f90dcbc46eb97b6b2f53dd97c8164efb33ff82976a72303b626a2cc4ad6e9a2e34f7b32dd9df0f070edd7597fde386673a531b9d8558bcb8d9c7fe19f7af2e5f3b12c99446ffd5e39b833a84151d3a4b76b8de59193298cc7fce72216b31bb7c6b53116a76b3decb364ea1a36a1d57194a3616d8a26ea7d68f84af3fb2286f4337ca8c3c74b7040edbfdcf603bc58970fe5f2777c70d7172648891b40bbf1f16ac703367e66d3ebde959f6e3d76b5e53b486ef0dfc8ac7c2db376cf51b09087f1fc448b1b188e212dee80e4abc5d92ff03a4ea2e67f1ddfe69aef37b2451b3fdc9ea34b7329c679efa73cc4780f4eb26fad3f7e8ace4c887f75754e7c366a1e7e5aebe5b8c3ab6af9eb64026ce5fc931c3321bc4bda84af1efd4656a8fb68cbaa7edaf1ff6b658753bf67d36a3b5f44cfd09e5cd234eccb7b29bbb465ff5a8ce7edef6039ae75d565bbcf1db3ec358fb1cb5188f405a437583a1b5df18ac0954764a34761bcead08293491f9d692766330b5fe1a9bb8e19fb67a7adc6975df4bb31795dda8ff5211cdf1779807bf1ac78409c65a3516595682ce82cae3d571f907630da3b541fc75599fa617785e3e7794cd28ff96ac797ba43679ff92ff0b33a46bbde53099f3bd4eecb1bbeb89087cf177c6c7ce89ab2ab93fc39f6de6db3c31fbd5570c1ebf2ee9599a933c40ac993f5d5f8f5b9efb5bc939fa4177bd1c6a1bc9eae25ce7be696d7f875a8e7383e9c639d6b7e92f3dc9947f033e24f9bcc8bbc4e6b5a7fc5ffaeb5fc12f4945765407cd1564e91e40bf9f2c76de5d49fb495bf9064ff6497260982205f88cf7f18bc99e2e76de527f8cfdbcabf132f34d5a7cf795506c4c354fe3095ff4153f9e5793f5bc94f543e16f7d66694599c56b61cc12ef9cba83d6c5545dca5ce0e624d25685083d2d820e95508da0bd24ed45e00382db49044595f12df84b1f441e4e8c73a0230c6d1fd7b2bce2a3e3874fbae94064eae2fe0f7f6188d0f711db543e5e704924ec1668d2301c146a02edb396646c03ff3ec653422d808a530993217b0e7f7e49bf7dca0e04f0e0d23a73b1f433fd2c60656b5562c12685ece6c8e3843671ccc5ed6497c816e6ea491954477d7468db5caa28e646dc9e69ff9f17c675112bc1857226ecdcd4830f57963b9bdb93e7b67234dcd0d8fd7c7e26070b93e73dfa634cd8e0fb87c798aa4c475f1c5f11bbc18e388cbeb35f5da316e28720f665a0e36c27e11f05deeee9cda9e118d5646d4fdc548f339928053d1ba589d3154768cdbe98e2b431cdf1d33845da2f6dbfd98439713734797306ee987cebac683086883c2a224d2e2b40aef254bfc696feed2dc267fe36f67dfe5c667b0932ff733f1fcfd8bbc9879ee0fa8e73fce8be93feccc7de27bdf49927e26fa98577e9217d753fc3c2f3ec17f8117532f14f17d7076e6ae77a2e5c6cc23c9d9831dff63767c3ef06756cc9723559e4285c7ffd8153f65356d3a98492ac3aa11ab4aeada9322284872df93a62f9e120d547eaa4d656ded69537fae96234e568f2c3f95e66aa44db4e9112cbd34e467d0478a8ac50d42930299c5a99e134e17632f0dd6b5d1b85a52dd0468ec70137a3b4119eec4701dacb0a116409cec8bea7b7ca7acb91042ac8902fadae3639f7066a36a15bceced584bb06295443b57674b2b196d41c9841645ec6ff73bdd2fe581efd04261716c61c492bf544e7ded1cce79e62b29009c1888154f1aba16af141000dd3818147f44a20608a78c513910c42016742916c2790c143ba82fe31802e8383919c3cfc4d450d4a31090821a155b016ad29a14339198574aa5a916071459f1436dca1c14c2795e10242f0685ac4fb58d33d34c6be32f400c435577fa0ea1013992329904999d6489ab43dea0d483321971e2543cae4940c9a4441bf17104d481e654c4e072edb17f75d1f80d97eb717a63cda73b418976c2986f12b3887b6b0620180f03732611f64c785e968312e80e528a4b5766186333dfd95c912c9148250f86cde5dafecaff1ead79edfbbda97d22ed80382ec329b30c87cd9a0fa9d5440b044a20444a0b846a78106310037d7d14142704dc9a14952925723c25e8465f98a8d58a33eeadf966a5f80b3b520f66385f8a955f39a44d387a7154f423b42a5f16296f6f4f01ab7199284492b45246a2140243aee699383dfe1459bb02e49416d42321ceecca884121441aa54191d108ff604db58913f1a44de4a590404e5588011a0fe0b403c08a3d139aba56ad5a112e6a2f0e06a145313b7c595c279268c495f969fdb0e820f31e1fa4c1e53e8a7b2b66a0414b43240ea1fe9c582b6d0a896cc34028fbc432146a516336873635c89d588bf8f13c7566d2c1aed2fd929e4340c1ca99cd996582ce2d7330291b1b8197c4db312e4b02c72258d8704f54cb70bd132aa1d937e12886d38351450741d74243516981e2fb6235ad8c4aa8846ae41bb1400105042bdd2057138f1427f7f68d281704284dd207e22ccbad59a62a3158389c479bd1e028a9fe6441693b7b7a84122de692ee1f2c288d4cca2e05d5d9aac97ca3859262132c61eb62eab06b42d7046649313fa5e95c138281091271ac2b73439ca67bc07af4faf3fbd688c3f59e8d83f37e9d6354c83ad9814c625f6153170aa4be0075f88b0f71c2230a6ce61536488f7962194ee9653825fec07e9de36a36b5a17bb9c1095332a324717227b48f1f8e53264e635a2652e2066268e8f378a5b011d0e710e87c6550fc41e4787aa5f85088c51884d16135f1289153fb2b9da7046548f17582b69dc369891d43c2954795c94d4941e10b4705aa3273c89522a9006a9ca2497b7dca242acc329376d68e96450a2d4d012d54360dc7ce949ddbc1e0a7ce0d4a65c26ee584d564b8666c55142c383745b5182ba413eb90cd4c725abad32251c3396145d22f411d24d2743af8b946e391a05dfbd9ee0c0a42bb4aef9fbb5af43f9f3d9aadde3baf76ccee00a59ee8eb5d1c2146ae38ee1fc5b2cfbc8f13d11fe07b275f67447fcff8a1ac778262ef563243003a0d41a8c5422cc562cc4660621f040ac442e81142e8fb88ee0af13c02bad03794e961a5b081c04de955cd23322b9120c089b722cfa67c5f54a6e55213a76ea4cdf508eed69554991b3093550044567c165467264629a3a983409d39135967801c675bab9aff72a341bc2460e18e0791351b9196ae52facca7cd90fda94687be9048632326572227aea5299c9ac431d0087fbbd27dfaa78254bb51043638b117b46231031be1f9cd1e5ef1c1f7f6f3e373f21b38820de26a81e30b3818dec2114b87c53bb4a244bc5228ff396fc67dc82fc59222334b570b21e81f966143cf29a1bf5290ec23d042c8464668576218312b658df85f28540629d472502052c03714c917a8e93d3ebc7036026357d244e2e647871a8446a51d1482dd99135f3139505a1119aa539f94217cd66251d13495b666f37435653251f54979c31a0235c8ace9a12f26c30a7047d58ef38332cb78351293b53c98a98a432a91488bf1a1c47cf8826736f17dd1c815e43eb30cf99bb2cf4aee977f800e17163d4f0cbd8fce5cfbbb68fa6d78e69a1438831115df17b9750562fe2086116128626028c3a310f387952ef4111d0521f485d0eb8bba7a6f8d2198493345d52273b33e8a13b07068670e365002aacf830a32b2ba263452dc5b2cbb766723d6a2e7bca5f62b99d61867a64de419dc09912628094f6a1b9f59b122b4632693e2796052642213642911042354a26f29daf80bb2cebb3c13d35a84ebc1e10dfc9aed1f318d1cf78fc2f8869c836918fa37fc037492d9034e45fb85fb5e2a5302f3bf707aa879a040ae14b56f285e0994f57135312a8302a158b1b1100a0791e22bb15af7812210883e1acab0122b8fbcc703e5502801e7f485d8a7573360d8500c1c45322d16a456c8ae2c459c19b1369122861622b05126be226aecd2d1455e22a38341c395436591aa4b3f174421ae614a38f4a85857ced29c1e27a6ca5480cb8f6a046459839aaa611eb8e8acf3d1266ed2196a19463b51fee774c6de60be73b44ba269b3e637e24420c46a7a3462291227101aa10681623060121d8c8a8dc4d009854a250c05fa2b4e0b8d70da37823bfc461e2c64aed0e599d45f9273568bec8306816a118e2c115eb9a8f8ca9c894099fa91abda5b899e5316a9cdaca9330293116bc72ca94f25c6897c428da2bd4878b4b5c9044b2e8875242ed78a7300b17830c9e9d1e20c72c54109f19b2e2e5ff3ff77f5aba0ff2764f3d2d099045cea57d7ef10cd294f741de9ab8a5009dcdc1729d1377410ac14efb0524601d045b89a005fd435284e90bea532c2440a8558b84b731c0218f6662ec9dc91b32927142650942bed973ad1323574284727f5253107c606aed615580322fb2944c735507cca8e999594c05faa9e29f66c144b3a93c89a94093396025141b811a000513096eaa44005e24ad37eaeafe93a7d25e7dec667a46756cbd0fbe774e2babff2a578fb8e68fb6bd6dd2305655aaef4352154e89f188ad5b02f84536235516991433aae508aa118898a57091318ded78f48d122b25425d912e8ac6e1187ed92f2493b624d3782c56ae6f82002b218b3bf4c4d12059d20d6ca68eb846024519abe8e0f841d4a078534fa46e470769cfdb215b692687faa71ccc4228cbea9f0a5550df786aa92fa5464bea01f15a64ec2cfe9b4998f132dcea46a3deb1f119e8ad5adbd1376e2efcb3ca73eb00eab8b21d88c081bef116ab7a1478a70346224ef18a4a038be40f1a511ae8f20c4495c4a41172883128ea2aef986ce93c2c409c13df95785f96a3292ec6abe3121cce5088e95245b99444ec9243c5a2c10356e6e2aac9fc833afb4c8f5c166d53d8833599e41d61e176b4907acca46073514376bda194924f8e9702ce972ded19c0efa4aeccc41a8054ee844ea6634ba2fff8ef0be2c37351ef3e3f9bf8400ad394fb4d74b56ac25162d65f89a0df1d7719f427212da273b769ae4ee587e3a2c43fb2d3fc6efa787dfa66df82306b56d08efd5669e3409b98ab6ed461f0d0c6a4d89557404fa943474f5b052ec52d4d5a348a9a4a1af99d5c43b0a951482c9fa608462b452a6c77bbcd8aa34d9a223ca99323391158525017e9a8438524220bb7196197a61aa154faad11148c93c54361221276c00b8757f0d9dd28aa65b1039cfa2e2644aa8ad8d7048d933309668908bba2309539e5871d2c29a89b93e037b8db3efeba333f160638750a33013c1e313b457c39d80e4a7b84980ae83dcc01f406086828ccaa7b7f604bdff6dbad724ec2e407cf4ebc4ca2f789f2efbc7b22deaa7a177d01775835a29d14144baa2aed2c2c4ae848aef8370ee0b93617fa5187d233408a04fcb95a29260c2bf43efa685a51f189b2b3889f64887129f6572b40484b830d4a3a9508383164b7324d75ce41b39e1a9f10e9e1aff604dba794df07a746dcb0dae1a8d2e35f745c528c589dd17428310c3356584ea112830322aef28526b1a4c7812e853420c7d0826c3a3a0bfb71698f69b2bfd58e8d13c935808cd24fba5680ea96ae2549f69a646b0c7f546db890998391367b3de8cc6c2863f80d0279429130b53986b53a79227c2c156b51580c3a349fa50e3e681a23b6b7bb63e1a70c40295344d08a71aebf73f92f3f17a6cc4c82c3b34bf4e9eefe3c4e2c130d884c24e1837bad578e8998950b83a5b58e3a64c117682dcd49d39381781cdb1216a7313f2a8ee61a9cfa1c34d6b79dfcbb0fddea6a4ed7a9cbedde3a04faffe991dfbd47e4d8bda3912e532b49915dae35a2fe81b21eb8ba1140afa9401a11f1a94418a5544009d3f88d43c02130f9d0328726220c66c645020ba478b1408471605579a5c0432f4536b9a55eb6a544a095b5aea9a74265a6a55f65e4bfc1c70e4b3a90d29797a5cebaa1f2b84385f691a655640d3e22c5639325558676d29a342e698b9cbf9953c630b9b6066ae9ea5f29401d6e6035ac4cd491003bc8fe2b8d69d1b9e52275d9c09b55c2bf78fa7fdd5913c6f93e2f84483aa53194e7836f21dcef37844d314b5d927b4f7753e9793c3e7983f2cf1feb7e5a2efd66d962d5de3c723d28e0f4d5bad7d04c16aa489ce35c2aff28c7b2e47e6429c5575db487f8c103e05c6468462b82ecc441c6ed07cc6fdf296fc2eb6f3fc2d1ed724d81c93bf6c243b5e3c239944dd098d8e24e8fcd108d7843099520237258dd8a00d2443723c252aa3589844e44a312a71e21d57cab05a29c2c190efc924ecd2e658604dfddd6ab6265412040ae14f5cb90844b958ca9443a9a144d989385dcd40e024f3c0d4d8853d13681015538d2b7e998454ba0af8652aa35253b35feb6ab475e3f90a6823c1a1b3beadf899bb19edc5097b049a8365923176f580e152674987f387aff5793ebe5ddbfafd3f5bdbb61f0fdf37191b31334ad2773652ba54eaf65b1962a5447d21b4192336089112a15079953891029143babdc1080a88c48941aff4790030cd16fdbbe73632b6603322255dda691ad4ada9439a1cdb37e2f9714149949c4884431c7712196d751df62dd6cf155525657a24899a5dad29c6582b5aa8416907b4a852c951a04e25d9a4c45f8a3ecf4032a72d1500831073400c4ca07f2843f820560b23d162106b253fe6c925d63f910c7175173869effdda04a3ad0e39afcf022a9bdcd4ab9865f8fbf697afdf11469411f2b4308150549c70a50b0450868ca180184c0cca50fc184c227aa57884c819d44a370e2beeae6d3232a7dadaaeec52d34681c43123554d193d2a44054a6b31191172cc6616c5a4ea440bd7314f8a1376a7b3a35cab8c831c6b07ab82b2160c7c910386c8fa1bad821347052bb9e24b939ea7769c1e17f43c7163182b9158e17bd97bfc348104d80835dd92fb87966e19e744f6b5133f92e1b1acb16ee96004f439094accffa8f6fea0d109a206fed8a1dff85c2df53a38cb2e315da53be519ee3320737323c113bd3cf7b7b70232367526b3291ff3567cb6cbf3986d3c0f632776dfc52cbdd4eb5c5fe8cc8be373591de44866682caf88d606fd23d2fdc671c383a221b10ca39d70f3ce24c2b8fd9bfc3e681215631c6c7e170dbf6b708faf044ef3c14420c4c9f02084517f35310871e21182ce06c28427448e8d579c4088dc3c008a084163737807f7fab65c1c0552941575d0972848299c3f56625603538112397faab12c0df46cb120bc83361eacd6216b281b49d5228d56a05482087002e58c5c0e325a351a3bf2a0af11dacce4325da61ccd20c8744dcf3557e1b78e2a6ec047b8d724635eea883fce1b19fa8c0f40d7a058a9445376ece05e66377ac1b91e96d3027723419b185698f6bca521f5fbdf97d3eaf66b5eca389c562d95fadc34743ec6ff55512550d34a0c475098a8fd1507fc950e22a078a418aa478392429132fac2448b04ddb87b77e9c62ca97059092271b6a07dcaa2a16fabc7bd16fa81c202439f32b375252d6cfdd8972291745871aa11530a4459a9712fa59e6894c242d5d652da50fdb1c2668aa20ef636377f7668a30f8282d663517763a06bd3a8946a5d7181789ca5b388ce976bb65f617df0ed39a85a3df137d713276e37106ed467a1fb5ca0b37d3e0f465fa89c50a0402086368df56e45a8c0840d413cf7c509927da70498a84741712231e6abd5fd3b8c99b1e10f6b4d9c8ad3f9d4558b89c8f9b938956879c64eed98a4cc18e177619abaa35b111b58a1c60bfa8076628258d3fe44e5a44098fa92c469a943304051b383c54dc90541940e3b2ad4292bbbb14fe81b8db6a7febef691c9702022d01962a94b994d8ff63605eb0fd84d8648deabdeca2af5fbdf9755704029b49275013858d9dca0326bd7e566cd6f8f67a9d4fd36b80d0524f3516c2c522a8d641961e2d146a552820242211c1e446a7d102708c7a33ee289209edfd53da48dff5398d8b4c8c1ada40e025965592d845b897b2105ee652b56505f935a24a93c65852077a322916887b4395107da3c3767f35f02cdced63aa06dc59f89893696e36c6babc7d264d748e704522c6eacd8eebb21fb2c7c6c07b923c39cd710f30d75d4f2c37774fedff723bbe6bdad3fccc53b05eb0c44676ffa0625d0a2ae1ec4c928142b9e36c229238620068a0645dde81b15f481ae9622925f1431b8b737e614ae054263d599d077a63cb3d6d647655cacb40d4b38c46064cf04c2d2f3528f07c16a02458df44599cb788db2194b87b9140c7e89dca0b2624652587fbc26e6dc3acef7ce0ccca5724068bac058ea409363b85ec7fe5aaf7d2610ddd99b1484cb8d98018af19d9956027588ed51e24d5bfb7027fe23fa33d89aba5ae0a4f7314b389b79bbde6fc721134d7f8d1f83221c046ada5f4de68150ad4b83122aa0ab7d83426b28fa6215312b25a2854a8a0c9daf0c5d2d8df2b6ce24c8045ad73da0e7ab359d4d456ac0585395025a56e85366656fb2d2dcd80dfd4063d68678ffc3e15bfcabdfff13fcabe7de5d07440bc6273e17009d2784ca8162352c8598af8c10e92fd1415060244cbcbe181aa4500d2ba0cfe14a178e2237bfabcf2c08ffb0e280604f54daa1184da5d707878d0eced46775ce239c7860aa91b4b30858ea7074b4b8974a624799ac48cc4a3fd2a01cfcb237de565007c54a59efb50a9886369a2aa4165871f6d319bf30ab8d4d8949b65d5087a3def039c06981a33bc34686bd65dba1fe896f4cddbe8de86c232f13e532344ebe30a2be3e0aa1470a8a1681705a891c88858aa781121d0c450a578a188b9c7a102bc717158112aaf511dcbb9bd4e71391d0723b9616869aed546e3e52c7f951d20784be01473d110381d2426ba2d1a602c6208ea87594a99a2eae2cda3988b148d9f42811b8cc706346d6a6c5d48c89bda43823c039dc1a02b8d646baa232a14c38953c253fbc0bb06b1da195d1b07e50dfc14c6fc914c796e6fee699c6ed63df3324778e89a6bd7abd57fa3c0413b504ca9430aa2123563625502a2d4e602084366154eb4a9c00082673282ac6c108357ff58e9d4390c96235611591d568395e972ac72846941edca9243bca88d4628730f47cd09973ed53f3562eaddfffbe5c7a3de7a6bd7ace4668306238a5044a0cc54aa5d1bcc064c81894510ab11822f9144ca6a458b1815841285620ba8f63366d451ea54e40e4aa526611d91e10ec48a247a5448f260e0be6969aa5363bda2ee9f97e4d6791118ab1ac7b94200faa25c5ac6d0ac8623cd8b92a499b84385283c14f6b2a54ae3a9f08dc915569a05924cbae3762256fe6746ddb91209abf45c3e16b6d33236ede798ffbbfefdf48b57d44f59945e73a1e3097fc5660c4caeb8b8a4d889379284ed6087fa038e10f62681c054aadc42a22c4903f888a578a311b8089777887fe1d97fa8130595f5ed33e0ba629b926e68524172b519b676b0817f60680359ebf06973ac8ec4424b00d45be65d7ead8467f6bfee73e303ee96ad1e4aabeba77511981132841999262cc1f106f03a1140b139e1415bb12e2e95154fc40080dca880504530afafadd7b178d64fbe6146c6c22271c28d13204a1ab0f7473034d3bf13736e51d30bd6ef2983a9b795ed3909b7ac9f11fea258886a1b344daf101fb32769e9b3b97462fa1d848a0402c72425f8cb55088d74857af84701e8bfaf420c4426528d017c32165541189ce81a844efae830dd77b61066722211cd654c1281376bfa66dcad2c9b14d1d0375c26ef13a6cc4d04072a0ee13967e6ccfc30ddda17eff0ff0a13228f6006432753832af7d7abb7db7ebd1fa7d0c4b51f1a1a1ab04988891c0098795621380538f4635a556cab4bfe2b458980c49415f1fc164caac26efd92da6c55a3ffeb2156da5b22c2dc7d24ae444ce9e3a739176c66a388a241226cd7a1cec1816868a7d5d0fb7e4a2fafdefdf4f9efac0f7705a65cfe699a54f8ba6dd66fed3ca08878441498140a994516911928940181d0d9da7568a471b8a47ad14a437c140e0585faceeda0ca1446b9c94d8b4b61945865c24e26cb855559fb2a07d5013a8eae381a2c5da621d49e2927226ce941ca9d1cbc1208ea43699f306a12d2d28ad9dd8ffa585c09728ed6847e278498b81120f0ec2b4a8dc98389889e86ba44ad47e2f19743836a9cf98b713945b67cc6beed17eef8ca1f66b7c927c80ef23bcd37d8438617da060ff6f4ad4b5d8508c52088dbea16b0188357f35117da37222b18aca155a472473be2b5b9392a148a418ce0d271965723c28ed60700415e8af744db1f4f944d6857acef88e49fcafdd71d71fd2aafd434ebf959a66b7bc05e8f3c8086d4aa8341ff1e9952ed002278546258588ae82503d089375b99af870c5cda395d2f855dce42d4c298422103673c9d2c5504932d6a1595e50595626224a99cc155569f26a63df6d71b8096b7fed1b7bdefaeaff83f9b77ecef80cb5fee245e39fdeae01214ea22392845713e320ea8866b2f16a62942b7d5dad74a134422d12263c05c2a88fe4644111de5b83dae742f5172bf5855e69f3a335d30e263b2f0dda2ef5f160a6715e655690d012a3afebe2ded52242a68a54649da9a2694026e7a6b6991ecc09bb1766766927f3d29dce8f8ae2e816a5695ac22646c8d32e776415cd572d0809f1235b03c78606a51df07d66d2eff81dd51fd2bef2797873e779759771154bd5269ab969f3b9b4277dced6dfeac975ddf1c53dc10d3df9d2efe137ee0abafcf6c21fa59edb254f3a8f5738df15736cd2bcef77ee6e6353677cabf301c9baed69a76d8068595adf3fa89df724b463072e7509da94589a9b1161d1f3ea246bc867b83629d2dbb607bb254ebe022b93d3a2c62faa6a75757e3c6af6532bad717d272194e77dc279f7c724619524a2abd09d0deb7b68b94f9f60f0771ea2c2d0a5c80e9a3b92719ffce08ee43296a45eb7ee9d7765e8002ef5ceddfce57ee10f58d76b76a60f7cfb21d6732c4383a7eb8eff85089d6e22617d109f74c0334cfd2166aaf12593fb6467cd6a7b980edb326a194efba73b1e84f333a1b074ad0494daca43a773e4c46ce9cacd5ad7fef80b10c39da11f4fdf23f82feaaad7f88864cdeb778ddcddca9b3c0d14271226c303884124285346a8fc48e04064844352a8d483181b14d0e7be11ab0c08d5be48bd277763f9c25f6b4ea8552aed6c7c5d49e6929eb09cce65736d36da08b438d734b690a7ce4ca059c50861a1e96cba52c94451054289b58d39657e5a53e6973ed580ad88bc09e723273a50c666ae39313425c259d8c43c1193f4e026e2e623ffcec66652dfd7057da6ddabaeec7f3ef3679a6153a26f736a61cea4e2747ec6e7f363517da4cbeccc8d98629e95d4feca677f8ffa1eb8d37ed5a129edb738e0e9beb0735feae8ccc1bca09dd8d6115bf49c3636f308e8d2f0b53eab37fc3c862deefda68c0ef676e2f8762c41307eb97a260e17f60fc50f85508c0c9d3f0a8a4d8aa14a1ba11ffeffd8fbd3e64671ef6f1c7e2b57e5e9b767cc6292b8abae07f1028658728341807ef5ab29b6b0094c1bbcc055dff77e17e02d89ed24dd3373d7bf8a9a9a8e918e106839e7483a9f0f60acb180d1fa268322939139a08b5b9c98bb79bdaeb9127b0a1674a16a31e71881005066ba1a3dd2f9e1c64be1bd1ebbec8c0d7e2ed0a4f6ab92dae65b93a756a7be9f4767baf6d7e65173fff6dd1bfdbd3fbb28f7fe4485c74154ebc4b93a8ce7aac340d5e47032e9d73e9619f1c95cd02833122b539d6ca15afb1c13f6aa4f8586d833e45253255327ee1a26cece31fc954a434e658211a2a4c0ac6a9fcacd6d462c5ce1b1a87da2daf791f9fe3e0ee8521bc8bfe34fa7b630086786b29919f558417da7698ff7cfb0dfcb3dc4a644b0d2cab9ea6ce763379a8fb59d199100aa4edf4ce412a86e8cc71a67aa7189238dc38946c33126377d2da13081163ceb53975375b87552670311417a024aa772274e3a1c5a5a3002d16483c2820786242243d2d564b0b2583c8289b4538d4c033c4e50646ee6636ca9091fba637e055405ab1409d0d85d2b53fc431f43c932f67bb9ac14980c4a5c7d5be074efc75e9c6ff21a84bfb347e496a60e094e513ed371ebcbbfaabbf167cbd911df2f9650c561bd0686825cc108940dbea792ebb54d685631830540c1c88d4cf589355591c3c9cdb84cc38a0b598d330156247627d910255c86f4dd54a15d51d7dc7b906486c7cb0c4a82f9ac520aa071b1c5481648b81f0e858467064a40433166f91c1ad9584b826a46e34a4364e1a69269b24adf62155de7336431a4bf3fbb7c1dc3413ded669173c52e3abf339fdfc48ad4e3b85e5f3c15ed075ea566cd04cbe3fca69a355144221c4d2a10010e476007c680c12a0a413d665591038914e1448acccaa7cd2a486e62861152e454ae16a341a45144c7095cc224de290692d534dee8065c68d400c924d05082ad67962c209dfdc4c6b272f46ce445389b2f8a0c4eb39f6ac26f1c1d6e14822528ec944584b6b6e03080561885c10b8d52c65af2d17aa14e972ff894646d09a81e1fd56bcce6aff856e27f7e2c9edab8c0d1d3a3383eda56ca66486da7294bc7d971bdd1ae039e9b3e1dd1fb0fedc2662faaee9b8bf18ce5efec45bd190325b5795d3728da98a2c35e148e6034e1b0aa24731d30600cb673a15e536abbb92a737301eca08043339a6c4d9d8fb02e85e03616c642bc6282096d38295400c986d664b23319f45336dc893a259192c21852316b134cd949a1016a900381dfd98c122c6867052650d3634501061ab91162ade99092192e9a0b5a7f21a08516677d2d055b8ba18b19cd271fc56fb5fbfdaffcd70329e4121bb5ad068758c723f6c2611055af135c617024847dbbbe3c7c93ac8d0deb0fc4f1dedf196ffde3d89b2a1b4bafd7984a8613d2ac0bce7c9f57f15c4d6cc3a85fc2f7671fafe2bc7e654fc1d2ebb5ae59d802a15a9c31896c866366fa2e7305ad68cf43c5c3de7d09c66269464a0822949889129b95b8c3632981aa48cd558732559985021fe04463410538300e6e9e95bb1184bac059b20e193b25ac452b4841d9c6d6c8d064951cabee42e7038275e58716819dcbba39a471eee90353ad14c55249e0a458c53a37d790bbc62aaf99b4c45a13088016b0ea242e812ef18eae6cadc8df38f107fc024d1c032acfd76b3f22cadf6370fc1fa3a7aa99b787b8e9c953ab1354706dbfeb37e62c2158d758a8caf5dae618d375d8f3027b4c14d6c10e463e0dd580cc551498eaa40f741ccfc76e0c2a37812a8a4025856602431839dbb9205ef54b9b332a556170e44a20de61140e4413b9c4217003e8a0b027f4ca1923a2477e652df28d4c29b98b826c4691299a828d5af115d6b3919a049a2b707d2fa1177a821995463b2b72733dc9760ba120688ab798868222ec0c9d28697b4675785fa58d655d5c39a30a7fe78ce6469b86c73db43ed645b6f6674dd5ac1aeea008c540450154dd108c9f7650f5193c9eb090917766348c41a595b7c6391620e7e8c1c425fe4eae64c6e603cba4f14e6687b3670a532a9214942892aaf59919a52450cf2a90661b17a1cad11c76a1e24a4983cc9d90f173e5ce742d2fe75a11eb930158080a3d373200a750b09381aee9dcced4da9890932e834f7bee804be7f4c7fd9f5fd321477dd9b4a99d04a47e7fa7dc9fa51eb07b11a8cc4a8ae6634800a304702c05507daa7de3128e4da6d62d70ec6f71249760ec9730e2637cfdfc187ba95be1b2904dc4ef9006388d960a5927aaac92b14c418cc7f2a0f946f0e179a8a7e6792ee8d136fdd7cf90cfdeb9d1a147bf621fcb7ed09f5b9c4c4ab33229dc9c73c53bc8800a8e4165aac318eb32ddc429307c8055c0c2f184312337ba196b540e1885e14d381a605b985488cd3855830ce2f91f3a4fc8420b18471bcc5c81dbc989427b44119d69b6d550762f1b70b5a0e8a51e49b3670aade584a8aa4aee151a6e161a26aa3134176c104041c118659266f0c4a5f7e3ca180658509a6f29cae3868fe1e279eafcb7ce53c9b6dd8be3f6188cf36b6ad7c411efcf3ccc7a8e563e0dc790605d6c62e8e66391066387c3895999aa14cc058d3119a9c50a8cf9105c39f36875e070b6583c3260ecf66d365091301021e5fe54235ec00486aa30406054842eaf642693551a8286a6ef963326b03cbd98cf27c5d6d6e804b208bbf4d000b4b832f5c28244dcb8d5a4af273b53a382918e824c4748562b78d081558be523076ccbf6cab9e46fe0370f7534e7f4c778e0fd39fd09bb19c515d4656a3ed6fa2081a1a94e98b900b630f22b388e69c84c68284c2aac3eb14015291c5dc56eee668646ab89a22a895bb97a10a89ab451e3ac5e09ce34c18d345ae92bcdfb67053660432b5afb3d0de6e8a2df33a17f274eeb0cdb54b7c1cfe63bb5cd1e3175b8f77ecf7052e1482c4132e14c9d2773dd6ce202a12e979001ec5c3569a00e0988fced5c9db0b0d65d57f9e526855d0e24cbe003277d5a2146dc0181eb43942d1481a48bd82c353d889af975c0dd4d861e286b5b7f697e81763ff8d7f5d64fa7a439536fcfa64f583faa6a39358e6dc09a2aa080ea6c712432a62aeecc44dcc148a440a4c473818f4d1d86703cd90166c2cc75ad9e7bb778c3367042c2858e4493923914d3a292f2132d1ca49aeaf298cf62552085ca6203c44a6cb28160250ae7a125a7a630d4ca223259c974103694a91298e120d7922d2deb3be4e8f4564efdbea207b289dcc53335a07475f2216fd81986d917497b66723a533a9e57d779d421de6894d0040b24d863b5cb66bc9697e6ebd3096bf14bf3f5753d755fd93a8a2d7dd2c6d31a62eda75727ec8dcf02751843864f9a33f2486470142438c101d02169fc97da5f1c6b258c4009558d8537e208ac6930d1a712ebe8f4b39b2c37cf4c708f29deb078846c8dce51346ce208f6e70405165065b252e64c95664dbbc7845fc3a1fff21cbe5c5fe383d4ebdc142fe8181b307212b2758541d9da8ca793cd889eeab56b00558dc182dc37239982aad907aab6c53ae0f0980fe7aa56af8493b93a8ccc4464c0757fa4920d7e878853e986c2a3642068aafb0c042947637f6351340d62adf6478e1f18fa07cf468e75ecc7cafe4314afe3f9a0aa95737d42e1b1c9c248a3800a28ac2b9159d5e3c2649ab6a8500804b30fd57a4d07c90d7f4cd7187a0574ce429ab6850cec6b7456a0643037639942fa64a3568d3fd6f21be84a58fb0d702c5fc1abc9bf1587dbf266d1b1199eb8b3b0d09c075167b1b889a94e76668588190d039399ec400528b3d6e1158ccc4889a12a25401d46509f3020724a1cc537fdfc19c5fd4002064e0a659960b218bbd89d6019b350472c26aeb65d411efeb408e99b68b2f3a60a045a019564c92e04a56f4fa4dc1310a7c6bb7b6b4a9e51eab34ea4947aec3ecb68b981c96eb5a060e6f2309a515ce1ec6371f7efdb727b50c32367c445ece6a25ffe064fc72b7e8a767c9dd5ddf8fcf598760efc59ec5c95391cc57dac8aec5cafd7506007549240d52941a26d710428dc8c458998d5d376aedfdc477a5ee8c1ce8e61e05031e769f24a636161b13cbd98487dc443de4559ac219f9653a4d804942801ac33458a8626abf99464da180d7515d18809b6786a52bab6a5504cd16685335b20998e5c1953cb8d1d678a3a7d623fda473a8db546a751fb33fee73d3fdc6e169957c6b8f93bb6bbed8763fb53fbfbed31c99514e1312150070c1c6b34a8fd5e3588814a827a5e9bba4941550a4c0627602c738d3f7c0b936cf85b94f4fb0e3b949e59975e44fc0f48008b192e34119fcef961df1a2b73350e424815ea42e30a05b9330b0d79c84b33951de66e427eda6131b7181adb3abdc0f4133317e80856c39f204621468aa0a5b0745837d074b4f7875ff1c1edaefac3ea6fc5005fe381db9dfc61d0fa7755109809d8e104509081f5ba7f3b57fd7afd4ad76b5aacf289a9f321acfd2346be760ed6e80a599708a6247da12a9535c6bc3dca7798e2583796695d239155b96b8d9a543075893276fb6e4cfa4aa56476e46fad70806c2aefcb1a9a9ac85ddbe9b2ef46fcd413066b5583b92bd0b99a025a8f8b5061713ed7c59dce7fb0f725bcc265efcf78cfe3331462a6286dd7cbe67a7e96bedf5f3f8bc5fe54ccc6c60ee925d6f9b89e17afcea5fdac38acd7ff8198efa25d4fd287f5f9913b0e464f7d534701167004c6c31037f8ac780b742581bac9cdc7e60e567e1f0bda168f350e32a07ffdac13a796814a9cba4337a15967c2c7731d170a655256822628d9f67173d64957f258bc12932cfe664c32ddea680314ad4d38c45a82ada96a7d1829c48c203113716baa268323899889c6e0b15336fee31807604ce2f91813701b9f4d61f66985852d6d52ee0a4401edf264e54ea4ca89f14e565d71c1b8a525b8b2c3388c1e0e789c04c64255527bcc8b2e3be9cbe1606aa378038481a94778ad44c152e1317168b051d2e15ad7f9c98c297e2026c09a8139dc9eabadb1ee6e5c8104b58fd7ec958797e304eaf45f6f478e606640593a4fd96c136bf2aadee65c383c9ca9c104eb7c8423b7e11801110a60e506588d9996570c073092fbf3314c9a535a155030c1b731657c669a953b0389b6d204972c549e281389ccaac95636a4180a686526b874527e3a9fa0ed73153cdbba492d6232018934c7953b776328ab74367f668a448934164e8782a6c5ac6da002c43c723425c3c260e6e85053d9bd5ff12aee43793ae0c62f8ed3df5ae3bcd615cd987d1d7352ec75c7e15c78db9c575626d5d8aeb15f998ccc421554b08ab926863e8a59a0f30148640e8c4d0e8eafafcb1768c87b249314d64de7e3c94e5561a13103c39e063b4b951413a1e8b6ee3cc5fe9f9f138e92fabd0262ea0a399cef5de32b39f2eefc72fb1dea69d640af74eb7e6fe770e6cbc24a0a81c01398f081a96a25d44135570981639f3375253175990302a0c018d6fa2102d1ed335f87cf86caa21016f16ee36944f30c2576b47c8b54543d535872846c0e26990ca8c1bd92c41b570d16dea8e051a46d50593c23de9dea13a7ef4df03d36300f29fea79ce089a305ccac0a18751cec34034ad624583b485aedcf719ef77177b493ece3f8cb2b71fcbf7376ca9e629bea7179aab389dfaf4e67a62436d509875537c4bad6c7e3498985dabfd276804131d4451646310d2b87038c1481a496b9195f36b60c42543610d068f0aca7640e756aeb4544c62460210fc772bc643496a45ec44f1c61bb7559fea72d04134573a73279da290c57218479753cdcc2082e6dcad9e104fe040c163d9d332d95cc75ed91b289fb431d15bb968f376bf6df5ec5c334f147934bbc7f6dfaafaf27ded7357a6cdadc15fc77b137cd1975ab63631c995b389eb0f3f1d316eab59eadd76af55a4ea6e6ea90c04aa42033d999090e4cd56471145cdb4bdfcd5450cab144644d4a901ef751646e357a427b48649472b0ab75a95bdbea7458da6cc0cdea7935950e1c39f4158e1ce637c6dc593d8dbdd93ac9a0ef2de876dfbdf55b98a3bd1140092ac040f589866399c5639880c42ccdcaace6028aebb56b833f8f269499482148a49bf3190bfe06a7122353926e2358293cb9772bbc9eab2263a526072b25c582b2b4282ce384cce74220ab4c5c01de0dbcb1486b28e3f4184ddd04e5ae06535b20233be50b57a35648cb0a3946a519f34889c94611a49f7b7b7343bf9ec7e53d852fe7717fa327fffc3ca2d5bf71ab7fd3216da7a4897d6c705f57622b8ebc53bfd657c73a1afd30456b4f871b2c687bfd201e3971a00a8326b6261159b3e20956e51254728923b102118a4c06119ca0005471091340cd85ebb82f5bd8fdc014c7b8e3407411ff5333b0e4a10042d664aca428bc49dce0be1c033ec1b0cf5ec4f5b4e9bfbccff29a37d839ae3fe1988fcd8a0fc0784263b5e14bdde168c280a85e43e110ab6067ea380491cfc264c299111f5ec377b73cf5788c27d2428db1a68ff9c4d334564d444621648390b499d150c6aa596a1a0c6483dc2f528cacd45d981aa9ccb29863341c293a972d841de34d7061239f866121eae970b9d05c0a4543136b3073628972264a89a67bdee0f435cf59b3c71b5ed9e30d7fc3ff615fd7d38c2303112725870f9e168dfd3eeec98b2518c77d388e4b3086a119893416cc3e8886b1a93a344c34168e9d2d186b7d58f93468dafee93a768e710b452f52b5729ff1a8182b021fa34adb9a146f2c84405512326cc6d25beee4691b5f7d19e324fe4e4ce61778930f6b1a18e108b0602c560d364ed77666255278cc075045e17c2cf7c1781881c46cb87360e46fc16dfc98e8246ee58d8a8d93f21b4f77d7b61e08665c048b89c69895c8c178b95af0ca5043c1c69b200126eedc35860b95a10b85ca16364bee71ec542603876e82763225952e2b6e404afa9a8a462891370aade450e7539575d5fd9aa6340da768702ada53130b79790dfc5b673fa73a16b5bd9502b7a45b4e2f553ce72b68ce0ceaf5c95c079c99800aaa38aa7d46acfa1c8834c6d4457aae3ed1757b0346de820adcdc238593c1fd7ca2a085e1c66e3208a0c1c34582724f205b2f8937969649962a6fd50a450a6dee5c8ac3ce182d5d92c9733e9b035e5e5909995bd476abe968ab4fc14a31a03247c1f35c205b45533696e6b3604a54a46532a03fdcf768f8885e731cd5e9af63e65ec76636f183e7b152077c4f098f31f168ed08647bc2f434b15daff120f1137de50c80fecd338037b89386fffa1547cb4cd51a1b7ac0cecd559e9895c9e1b1c8c144abe74605abda97d0383351123c964258c9b459011a477e1f44c16d8e1a462e11ca468b5859e9c20e43ca0d1769903894d69f311cb3600aa4206da754a06f567c65697d46434f8ca912198d09b4b560349fb86b670a238da1e182c08d120540a548e98e031a8f06ac365536564a2a38719867a65db38ae99b18aef8e93f3f164fedbe74f8f4288ec105be77f1f77004ecdbb8b1c70b69fbbdf1c3f9cb58494c75c2005ddc02d567cd7a6d3026311efb9499881c50fd2d484cce4ce43ea87cda6464e6266f2422965dc1d97c2ca9cad85d5806c9c044db2988976d26035ee4ae1c016d6d0295854a240f0dc3c52448010a4c5d408c19a17811c7ac3b1d726a240d95693035693e5c4c1cd64d20a7c5c10a083074593ed20c2943c63e6e2b8581d7c4c1fd2376a0328de68cbefefb46dfbbe15cd018a89abbb93a0c4124f6b1eaef4cd5644c1d05b092fb4d3cd1d82fe7028c412257f0eaf74f26055665dae68925b364a6326ea0a713c62690c71497da6ca68231dc343690e1fbf2b83f6879b72f70c834cff8eb3199f5fd1bfb5fff6de3d28ef197f3716dc7018555b1825510624189a0a0d1a0d2289cf021d46160aa3e07752581d5640b0499bbc98599f2ba323539341d0e55827e2a09b555a380565534f526db0a12942e98989b313ca5a7ceca1bbb584938652ec0954be1e9829572380d4c3825863b9588a742b44828da6ef88a51681392aac260eb2077aed1d212edf7951c06ad71bba6642f9e0def31adbf311f899d34317e0dbeae593baacee9ecb7e2e3b9206ea14a1258c55b20982c1c9b0c16eab523e89b8952cf4f02543ec642ddd638baf91d254daa505c5496e6469a460b2e2df79111708e30089156706ee4664e0ce7cd1c42f256a9cc3e98e2d57c1c7038c2408e254e61a0b58807da228625e29f4a8fc7739812e9990e7e6a932c5253c403daa430913987e2d30fb863be8a1b3df2755cc0b0fe6398d2167bd4f4377d8beff210172fbe3a736be61a73769e70e0cbd99dd5f131f7e527309c57f06bcf6fbf13d5f2b35ec428edf6dfa9f90dbdf0baae5647bc49530f18d143cca5c960d5ac603d9e05730bd4610219948028e660350ce6e320809143814adccec771df8ca4e41a674f3bce33e8a418b99446430115324d0c9b57a46726983ab1cb402deee309626d1d6e66d5d35631f0ca8b89e4d022ab1a7cb910066b33963967f258aada2051126eab241c658f5d62b243cb29f3ca46ce4a1724454f354ed3e047e3fc77c7e66ddc70ada746aff4d1f37b0c00fec7380f2ee00d5a9d66285b27195036db9cc36f70b88fed38f43ba37150e723331229539f702069bfe788d56160262881ba140015ec4005b678ac045835fb37e321b58cc113dec08964628d4c4db4e44c8a00670cfa0b81a37402058d79ac6ce4a6300da626953d3b8cbcaaefadc5f40f47e769537776726556789225cad461558687bae1f2661c40950d783de27307e1858e9e4a95e2d9bd6f71f67d850ff7cf7f638ff7d5771c9a366e62be522599e9d961ffbc3a9c05d76f3b574506472603229f832aa0a160565890b740d54a30060ca860381f6b2c886216abd2f5bd474dfa61221802423636afe81a3f8c6584a566cf4dc39123e0acd97b0ca5931ea29edee18d66e77a8a1a1eb0de97c6e46fc54e9ee15b9a763ae9c63d2ffa210641357766e5c6a60e76b07298dad6e271dc072a9fc0316061c527a61a73a0f27770ecf46124f6af73fcd18235f53927966240dc0410c26332a94c06eff47830d22205db6cc3f1f7066f25ede3cf2eb6c3f677dac1150659bd96740592587a1b97f72eed554c2d60b1ca87580514ac8609d0351656313517a4088e613c6fbecf07fa38526258393448a400aa5a7593e356e77f2c4603d92a07baa2072344bb9a2d04d233cb9bde4411746147ac58a9fdf8cc99d0b96704b1a9d6f70a685be0fb2a9f213581c8d170df668a2d98e248d739c615d0b32df46925e61485974a4f5856ba0e4394b6fedb91ffb91d674ce3c79df109b4fb42e61aa8fea5763ff10cfcd25cad759e5658edf83bd6d9e01ac3fe6e7ed88314341aaa5284c74f3416cced5c9519c8289199000aeb260b2a1263d5e7702435fb466635a96ec6c02065a5c54539372042d3805f8c0388054ccb3494218d332fce388f487355cf7e82785962e1b15ca462b5604901d5b8bf203856f4a2728d4cd634b8b627584695b2f254a23c3364f8ccd0c15c93a6b6fac4e83c3ff2d4e023db57605dc9cc43ec55eb379fc757dcfa9650db8787f8c849fb9dd359f4fefb7dfbf45f9f27e7f534673bedf3b4319b72b1bfff81abb882112138124ba84e189c683456275b503d51a6ce13a84f68b35ed745526c46f5bac7dfe2ab9c606d5c823571739b762b85f22b8d1af4dd88576d3a66357df073c1c45b5700abf904af5586ce4c43e2158a0ef168909b86c86ada80c763b874935d1f50780b8d985192dd04696e3ed7b62b4790293b92c83333d06cca85b61e2cf66768b49d0e33474007fd931ff7838efcb7ff804eda732934bae8f0fb4d5c3f48a4040a88ccc7daaec1eaa9e60e462884637f072bbf9ceb720974b1c4ba4935df0612d075db65c072ae3d324a8c674e420bf65491944899e10909d52956f558fad19e9ba1d26ed77b973148cdb7237fc77ed7fedbe3fe2f75a8e7c8ab061a4e3512c1cad942dd2c41f3ad08c0e2f15365322884aacf367ef0586461a544876fd95fe655f36935e1449332fbd654dba81119cec7e6ce1a4bb1ac2d2b347195db18c3d379d1e1bb69275ce8f5efaa8c524459faae9ae92d9f4eb3d6b8f87d8ec9ef7d9f837d5d4f33965e73c11cbef37538f74ee6aac6366b0b751880c864e7aacc9a91547b473b10c92c1cf311509fcaf9582e1b0e6cf5daf70a27851d21dd9bc83b9921164422ab4e6856a3c5ed62220d6d9a5f4323808d0d325086274fcdf9fb657e75f03bfba7b4ab93b87d7794e10613018e7b7740affdea7a7e6834d6c50a8c1dd6acb42dd041092a9f05098e60a4ed6adf06a85af38df5dbf195c45c243451139e0594b2b6e362a852c5b3a26a3b87da6ef484c798b8cf5ab2dd39939cc3537137474490596dab32920a22b093357137a376a183949916f30ad40b763e9970602a0d61824b38953295465bc4678c86b2f69b2f290e9c74cf097a91ef61f29bfba2f5fd1b9c11631a12b7c7ae96473c711473387a2acd0ac758972b20c894a96a1c8cf810082287238782aa4899eaa48f23b38202bee14793ad9dba40a920a56a9c6a0bbba543edd68096548d097e8298935b5d74f6dd2a6ae8edf9750b334194b9e8efaec61bfefa1e266b1a4a644d8781c9e4755b9c5fefbfe97c984b5202a27abd06683896a2f9580a00234558d05898f0111e030aa893da7f8c802a6ee158644cf5e6b72b18b50a860bca354124595af2c86802f9a1c414872760eb245a7fa1c399350e667834081663e91e1b3c322bccc2d1e0a71cbb399ac6a536761334010cd60b0d4cb89f2841532b71114894a95ca1524d21b5600aa24e90f2d1b72b30b3db98e11b2e2ed23fe370a9d7edaf7942c491e41dfd103febdbfab6acd7832d3e7dc25d8cf7ab75e3aff7d9be8ec6c73cfc2e0ef5edc72f331fe30844c3bacf2a103d71588d2953971220c83b338109d0516236dfcee3a3dab642e62a374e1b776140cb8e336b110fb2051139977667aa416897401ed4bec77442ab13482f127eede841df61762248a4beaae2d8d5f9a54a96abc584decad46e29137eaa2745a88f715f4bccbe37e631486abfdea5403aa91cfd919aa38ff1f0dea8c1c06f4ff1ea757a50d882445bfa2ede73c1718739324acff3a4a79728be62a79a74f6d775cbab67d8f7d32e74759263a15e7bb5f7dff7d50e5412c1aa59e1b144cc4aa3618422a86a2c56c52d8e940844528c231298cca4eed710a8c36be75bbb998638c8d0b256b9024a767d94e04c190f594b05b4131781c242058ca9e7bb6f7799b5f2d2e2eefbffbbfb11fb77dfefeebedd412bf1ea5ffffdefb73b3f2c82b5fda7b34c7a951358b9d7cb0b6f137adf7b59ecf7ac2c23a16315e132edbd84c4fbabf0928c588597d7370cd39765fdd7f50a2b244d52dadefa8decb7bb3cacbcbbeff43df5ed2e59badedd7786ee3ff41ffb749f6e52fe2ac2a6204331d41f34f30743abf4e03b37f84edfffc9518f1c77df7f60fea01ebf53d4ddb7bb30ffcb0d5777df8bd5dafb7697974dd5636f53d7f0f0f0c030d4b73bd05443df3f3e3c7cbb83244ce3bbefdcb73b315dde7d671f59f6917a7cfc76a785eedd778ea2bfdd09f5afbaa0e2d637a2beddfdb0dcbf1c7ff91775f7fd7fa86fcd7ffffbedeea97ed23cf39cbace45fd87bea71eefa90177cf7cbb83799df2d0a71f068ffd01f7df6f77e0aa3c777f903fbee17fbfdd8dbe283f0c574570a9ccc303fdf8481dcbdc738fdce3439fad9f6971ea8d21593a71debcef90c46d37f5a9c1fdb73b9e587e9b217869f37796d783a9f929ef7fd60df3bfffad0752e0ad9a5ea8fbe5aea7e5de2aef559613b783ca5ff6f295d3bb36daeebedd8949b65c153fac22b8fb7e75549e066f6285e9ddb7bbf1d269c7b46aad7cafb852b51da6a71b28cbe515b9bb6f77c02a9ce0eefbffdcfd79f7bfdfee168545bcc3306b2e14cfca97e9ddf7bbbcbefa3fae9779a9eba54ef9fdff9c3db3bdf6bd55cf5f16de2ab9fb76272cf990d453e67f9ac7feb3a9298b7dcfad7ffeefe1dd1b81b3bb24d62ab6ebf9534f456f75f7ed4666fd6f98fabdc44beeae4fea9e93b8757d632f6b2ab3564e106ebc5e61d5773f5c556176f7edce5ebf84f573da653b839d6592adbc3cefd9559831e7092ff5243f4ff0db3b1caf2b12dacd755a5861eaad7a24cc8b7d82b76b7eadcaac581e7ff4acb6caf6c209b3a079fdfdb57b9ee9e6d6e9c2735e5fba0cc7d1837709bd302dbc556a919ee76ead959bbf152324cc8ad039a504897576752cbeb252775d84e44256beb60be29d3212973b5dd4e5ceae9cfed9c5f90be48145bfba62b8fb57d71ccd9c5dbfa9b22067edb4e3a8c1ebabd3b32696b37c23dacbe27077f7edceb50acb6e06d04fd27357e1a6e9082f75966e98fa673f7b569ed2e7d775a9fbfeab9430b556e5798a936fce2ffda57d7e1978bbf3cba89e7867d75933d4bdd56ab9aa1ffe8558f5f3bc24c5eb0960132bf57bb9976cde4ea137b3f42cc7093cdfb72cab97d96f33aad25bf5569ee59230f55e67ba51e0adec30ef35daf8759e9784abccca43a7e72fddbc779c0ab7c5ea79f209899eb55a59e57e525d972d56de078fd548ecfb29f0aceca6703df0dfdceec52ac2a0e75889471c2bf72e662ec9f24d3ff84b7bfdf26291652ff056dedbbc9755ad61964e7c2963bd0eddb7e9feb297ad96457dd35ea3c1aee6363fde0a34c3c5274bff62c627cabe1671fef0bdf40fbfd15ace2acc8af76fffa65451666fbbe9b24ccf4acb4fc9b9eb55e3c27d4ad84bb2e273b7cd8bd5da293e25dacc88c24ade8c287f95397f78ce322ff3c2db5f2e332f2d5696d32a84deb2a8535f170bac3c089de52aeb79abd576f5769c9eb2fde51fc99a1461a3245e0b4556ea3955bcae65f22c4cd3b7da21b2bdd42beaecbd997a3798a275b4ee59691ebe5720afb27a85656f57f5cfd742b1b709537bbd8abd5e9e077f39cbf4257c33eac83a0d77f6d209f2dea6708867a56fbd80347c5912d76946759215ebf04381de7b4571492a77569e97daeb979b7ec76da7e4fd7c7e2b716683b2fc331ecea7bc202fb13df7d7fca52b7279e12edf3d5f51a4edf820cb95659377afbacf0f73ab783ba58e99ab75ea6d43b708dee4878513788404b54cb04cbc7abdf34ae27c9ed8b556ae2fbcd51ffef2b372bd6df8b67bce85cf7edfbce96bb95eebd07d4ef69d96add79c478b7e9ebe26eb24dcfff9c3dae6bddc8d7b1bb6d610d6f66bc23d2b0b7dabf0b656f9b572a1957cad00b112dbb56e9469e5995ade5926c932ad9fadd6985f2ae32cd3bcb0d2e24b85dcd07adbf61f1768adf7ade67e5feecc57fc7ca195972fd72be76bed7028d4bba4493f5b36236b3f4cbf54b658c65efab546a97da7de7ebdf5f5824eb85f747cb55ce2fe62c165336bbf36c29a922ff9afd5e887c5af150c8a22fbb59264e9bf37309f29985859f6d6127ea6dccacbd7e417da74e515ab5baaeb5ab1ccf9b586d917acff5e70a43eb8c3c65be5ef5ccf0fca6c97ab38cfaccf4eff36e173b2bb9eb57eebb7df96ed2db3c2f5f262b5fc649b9f8a652b6f137adbaf165b792f2b2f0fbe5a6c9d7da244ba74bd28efa5d92d5376106ebcf8de5bdb7f59b42c82b7bd9c5b7610aeac7a51f0871f16a19f2edffa1bb9b7f2c33adf0d5f5e7af53f89553841d6ecc2bd96cc5e68b6e72ced95752923db2fffcf3356ce1f6ecf775eaea6f772c77aeff89fe5379afd7aeefb855abd4e2802cf2a9a8d857a29f407f1365e9a078517a6bdb3df6fca6d3d2f4e6a3beead7a649dbf7da6b5edad7a91e5f9deea0f87845e5abc73c92e8a9cfc6bdbf27dcbf7be5466e565cb55e1adf2c27abb0df141c93cb3d277cedd07658a60b52c0af2a9577fef395e162b8255f8527c5eb25eacf72cdf7beb4c7d58a6cdfb62a1dc4a32f2cee47c58ac0ab3384c9d7793e94ac19595e6753feecb7da6cc85ad9d734112dabdc42b56a1f3466867a555592f66ffb8d086ef77a26f64bf397cfa50745d2cffba6407de8b1edf6df967fd4e7f2e577ecf2a9649b3dddbced9266dd7db6f87da64b97d095b7d7c21dbb1f282bb9617584e6031d4b5ecf56ae31df69e2f099c36b02fe5bedf6bbe24552fb9323fbb9ddbb356496bd86fc878c4b7128b7c24755cbedd10ca2c27f68a0f847226be2291d9b1fbc25ccb5c929266a96b7d925fedc93c0f4e73ff4afe499f36897f354f72433e4e97db3458eeb771af0815de2a09d3f72d9b7a45ef7446f22eab76759b7ffcf5fbdb1f04deb5d231a317d4bd70293b7453eb62fa71c0356786b7249a0d3f6f157a17c5b2d572575eca68f626de66e465de5ba7cd71c4abf466532ef79cf5caebd9a11baed6ef274123d3a8c09765bb49f72e779d86ced26d6f7153203ddc61e913efcf3339df4b0fae529d6565615e3bcbbdda6eaef3cb45f69b9a17337a5651ac427b5d78d70bf76ccb89972f2fb70488953aad51ba2dd19cd57c426cb55ca7ee6a69b746e48a7073784096ede55fc7cbbf36f4f54275f3de78556799a69e53849bb0dd46bb22b5f25c2f2d428bdcbad549e83858af4b9fef5ddc96389d075c96abffd9fb2c97053e7e983357ee839e3f936c7b8e2c2df733e287cefa8cecfae5e5d6d83a0a3a41ed6393ea13a25eba396ddd7c20fbe9066daef607ae9f11cdcbf4c6cc7c257a584e7f20baf2f225d978ab9e9bde1899efc5332bcf6ba778ed079f289697b96391cf3ccfd123bc2e1b7b5e6691fde6d46591c42b2cd72aaceb12a995dc9c3b99776b00adbc17524ffae50d65739269b5cde9faaf0d6d912cb8f1748766be2e917bab4de8781f0dc9e3eae87af60d23d02bdae3ab6516fb7f86e9617db9fcc30e0929ffdcf46f66f6028f64deaae704abe5b2f89468ed1ebd84fb8172557899bfe4b7258e83ffad841f16d71ebbcd3adb9fbd2671361936e161ffe843e1f5ead613f532b24eecc388fc40a6e7584ee07d4af225245e13d3f629e1e52ab18a4fb4c0db126eb8d7f89f947fb5e1f2d942a1bbabdfe64b4552d7db7da5c0d28ebe5a47eda77eb94c5c1c22343e2cb2b423cfb93c7bde8ab667fa4bd23cd3e5897bbb48cfb132cb0ec9c195f962e93c743dfb60cc3e2abbf23687b3de0f65f362b9da2bc38f445fdb8f4f4bf7dafd852f16fa74bf9f8af8e1572ba9d7435f2c72f26f9a3deb2f96ae4dcb575bfbb872bd52a2eec1fd0edf07224da3b631175f93eeb9cbe283d63d144abc64b9ba39c49b8d985350d807721fe9bf562a5c7e6096f67289b78a8957acc29bcdf556f693edf6aed8873af24289fde87a5959c9d79e315dbaaf47d6d65aa561eae77f6ea8f3e4d24ac89f1be6eedb5d603503abfed3b35ce2add8436acf5939ed459190fd9fde21acfbeedb5d98b403aef97b0ced6aaf8eafd05ceebba5cd8a32afb67da7b5445978167995e464ebf3cb97a4c85b85734c4abde2b05b704c5be68703aa6352b66c5c9db390cdd745f62e23098b57c97998fac47b21a11fbcaa75ef65f7bc9de778e9e652d67eabe2e46e7b79d1ae544e8e4a9a5b2f5ee0596d57358145e1f2347a5bf1c46aa242ea3f3d3bf44f3f1b77b3f9bd5fd724613348ea3fbd26e029b39ac66a127eae9785e766ab302df6412a69b3f576d8083afbd9fc7368d55789fb073ba69d2d579b7d1b6f571c56c0f575eb89351133cbbc69acf6571efa6da165dedb9f7264ed5bd67f9af9b5bfdef74bf3cbf776d9f1472f2fd3c2aa5b78b54e8bf6c5f7bf7a4e732872b83a36f7717bf75dcebe89dfa5e7657e969c65abe5cbd9f5a185f643b28d1cf0575616b44744a7eb9e95656e3bbd3e2175aa7f1f99f39932672135758962e52c9b719917ab7ad6d7bfda156dfde7d414a7e562bb457616a2d6269c66f9abeb5e66ad9acda27dd3ef37c74ebf7aebe285be7f7dfdd85cd663feeedbddc64bdde5e178ecea7ef927a4ce367a6f49377f6a15f359b94328f32de1f39dfb1b7241bb3b7c43e2fd26fe0de10fdeb89e796e9ad7ff275e9eb7baf99ae0dbdde30fe50e9bb6b7044f9bcad7a4f67bcbd7b257cbf5d58ecacb7c6f172ee55ed90dbe2a7abe297c55e8cddef08772cd16f1ff7ebb53bdbc38a23ed235216dd211e7d12681a55b3fe4f7ff77f731ec0558617a40a2fc32c6465882a5fb0b457bfef2cf64e9367740fb808eef77f49f34db60c81a957d0498dda87f8fddf9085af6df16777068a67f08c1563bb3b525bc0964dbcb1c016ccce3af01d81eef69967bfc6d005bff00601b305c7ff0d0ff7b016cdcc311604653f70cd3ef3f7c1ec0d6bee1e7016c47f9ab00b60ba0b7fe03cd0e4e00b6ba373a005b0760eb006c1d80ad03b09de57400b60ec0d601d83e92ec006c1d80edb653d201d83a005b0760eb006c1d80ad03b07dad5c0760eb006c1d80ed4bc53a00db5d0760eb006c1d80ad03b0dd12ed006c1d80ad03b07500b60ec0d601d83a005b0760eb006c1d80ad03b07500b60ec0d601d83a005b0760eb006c1d80ad03b07500b60ec0d601d83a005b0760eb006c1d80ad03b0fd9b00b603b2ec5fc5b1d5127f3979b65a467f1649466ee3dade0a1f006ef7dce00070eb33d467906d0ff70f0cfb1ed9f66291fc03681bcbd28f8f47681bfd1adac6fdcdd0b6c7e377d01efbf70c774fb19f87b6b5aff87968db51fe0bd0b67b9666a8c723b4ade98703b4edf1ffafd0b65e0b7e7a056f3b0ddd36f33d8aed84533b81d2da69b0c7a4ed07c86b50da39d6ac957ea3715ae0d74921753aeaf774d43bad71d459773ff8616933193159d9d71865f56321414b87a5cdc20d4ee5b51a236d46606c95e27f46fed217c73bd949065b6c4894a7b9a5cda2ed8ffd37577ff04ae0242e7127b834759ab8cca0b42a6e8d0d25b2598998c6700bcafeeec768a09986946243119c842fb0e16e9c24ff4ffb3df9a74785416b6c48812ba085ab7394a5d34466066b9c90d46dd2c9c68e71660ba8fa912aa5ab6b8f33ca2576824ad3508896a2b52b90026b70632738c36c939ed90c2758fa8e3855ffb9ae67460f4b9b1d12275538859536aef1747847c91550714cdb3f9726f091c5f0294612c1092ab1ce45783194ec04e6aeae901f0b6968ebfcbdcdf4d7aac01766a204b3186e9c440b45fef84dd2c1fedbd95b317cda9cda6e18984c1160062db08e8993a0b5c968fe42e7227b8a62ac52a1acd381970cd6b37817d8ba149b0b4ec5869499fa2ef3129eb2f43a2fab6ca6ffbebec5df52df509b9069ddc62ec3738a81899d2a95180ef5c3778c7f2cc4ddacae2f14fd59d57ffe42dbcdb1e15236c3553683a92befb16dbeff5ab6f76efa902214d6e9a9930c6867dcd6f763f23aadf996ec7837b159658e0d656c0b44b39941ae2528c18644ec04127142d626b3a3b1a00dc4982f4c23dbd821074c9d0eb130592361b0b1a7e0cdf38875bbee8eed3ade6e168642ecd3bc78fe311ad4f3e527d621f5c35ffedf7fee5bad87b9de44412f09f1569ff010de0a1f21f0f4e3c3175d84c1e3e0a1cffeed2ec2fddfec221ccdf180eef7993ef7f00517a17dc5cfbb0847f92fb8088f1cf3f0409fd0ef4d47743e42e723fcd33ec25b5570f2115c036676e2f81a4baada2eceb719ed30648dcba1ea1990c23ab59699416e0b286a6c730ad67b1b9e9955fefcb6fc2cde65763a7918a5a8b2742e1579c839ac42ec0537c7867c295db3a6123175a5becf1a097c65b1e061e41f9fe39add3ddd6bf25ea7cb6828290c4f61c30d3c0d3158e728a7ca9f4f6538e2b2cac64e5b5f6891c28d1de5cfa3f071234ef8ca615061263b4e1478ca9d4a9999220a2f86945d0e899df0a12d68be2b04449c1cece7307175ae6da3d0adc449a6aa54dfb7746eeb1ab2ef0a8fbe9968bec90cd66e824a574071fb5df047df5c3c35be9828c0dc346035f333bbfe8b753ab4a7f1fdb10e04899390c016c83d36c4a53b255bac0f92e70befaf31a8b4743eb78cacf5a942eed9610e3ec4b96f81cae70571477eb6aefd3a87ad7d07cdaffdb9519aefbfd53ea4cd649799e530b205be72caa1707cd714ec65f6b2edff9b59f9e8ff9842dad6e9c049e3839fd5fc3f2beb369ef887fe14054cdb096c7c1c71aa2c4d43f69d0451ae21ad9d7218885398d9c630c78be1b3460de6a23008ad0445eea8ffbeced1a07275ba300d893bf843edff8f9b59f9f4e8087c69ea946fd7bea94a854e8276ae8e2a7724fee7c768b0350d29b0d50bf79d4ac49da2d24e412d576243a19d84ab5ed5716aa7c061c9da2c879a69c0d58f092f2f34b7f67959d320b5cfd0f79054b7753dfec88f70f8e0954f6b25456b936d7cd979dd17e2941e3c5f2b6b20e2b04d3bed9f7feb2fb4fd38a97de35421de546ec79b80faee283e7ba7e1c3797fb4cf8e03271c96d880dbda4fc38ba7812870c42d87affc426d2a6dbc29591ce5e4e5ebfb5cba779baebaf55c32e0d86614228e29ffb91eff2322ba5365abb14ae04e51253383182fb81f8b72fbfcfe1e4fbe389136781afb3f16c3663ccb87f9347a35a67f9849b3ee59da0962c569ee6b024f59a3a781383db6ddba4ddbfaaa8e2aa7f65feb771e4922d677b95d0e599b95723c1a264e3228c469f1208e38880d656933b2ff6250bec7e6fe8cd4eb08a5e92b311c0e9e17d79e3ba8751c7152503ffb1a1b8eaf08a49d43095f7aeaaeaaf58c9d38b9388594939035aefabe570e1fc49134b6198eaa75d80c299c2368e16cf414d673d41648d4ae7ffa1babd661a1e8bf2c8683f7fd4b3d8cfc777db51fc7fd7a1c558e30583b8cf6aaff5ee4e57e7e53cf2ff23feae3facbdbfe6c73b2d2fa47b577f43912274a65e8efdcfd7786fb936239faa1cf3cbcf763bf4ae2c41edd589a612886f9fbdc588ea5e847eee032f6a947ee91e6fad7ddd8d7f28717bceac55e15bfeec4be2d423dde3f72ecd187ad7ba22370ea089c3a02a78ec0a923703acbe9089c3a02a78ec0e923c98ec0a92370baed9474044e1d815347e0d4113875044e1d81d3d7ca75044e1d815347e0f4a5621d81d35d47e0d4113875044e1d81d32dd18ec0a92370ea089c3a02a78ec0a92370ea089c3a02a78ec0a92370ea089c3a02a78ec0a92370ea089c3a02a78ec0a92370ea089c3a02a78ec0a92370ea089c3a02a78ec0a92370ea089cfe55e0b3bffcb7c89b9a9893bf8196a1bf87b0dd6265788d666319fae1029aed3758191a381bfb4fc1d91ee97b861bdcf73f8d666b5ff0d368b6a3f8e7d16cf43d473f3c1cd16c4d1f74840c1d21c33fa8976e90313807229dc5b03075b21ef959661bc38d93cafef3d35212133773858036432eb2196a631a6e356378d629e97c0ffc2f30f3b8c107f21efff365ec0318df5f66237fb99995c3a5a973b12da0521448250a13df6148e1083b7209b0ef0afc0a1bc03717c30390d9afcb8b82b2d9a76b368db68e80ca999f356409a2100476a2e4d810972720fb90b8095abb53f09ac4010d4b9b0d38ed2817fbcfc29ed8a1e46e83d4175b1f1b5269b362264ef3e791b107f3871fd6b50745a387911f4baf40eaead207d1d376f6b494465a034abf3f01ab8f2072e3cb20f285988f7c32988d866b4bdf3ebfc8cb679ca2b559b663e27914fbde76291dfa6b4620651a12f57c6acb6c9452cfff2050bb095a6b8048b72ddd99dc917b68c0fc1a72fb91a5b987fbdf466edf1f4cdd23cb3e528f83bfd7d4d52fd75a16fa811d0c3886fe3c72bb7dc14fdbbaa3f8555bf7f0403f3e5247faa17beef1fe9ebba7fb27faa1fa713bec7687ddeeb0db1d76bbc36e9fe574d8ed0ebbdd61b73f92ecb0db1d76fbb653d261b73bec7687ddeeb0db1d76bbc36e7fad5c87ddeeb0db1d76fb4bc53aecf65d87ddeeb0db1d76bbc36edf12edb0db1d76bbc36e77d8ed0ebbdd61b73bec7687ddeeb0db1d76bbc36e77d8ed0ebbdd61b73bec7687ddeeb0db1d76bbc36e77d8ed0ebbdd61b73bec7687ddeeb0db1d76bbc36effab18c933dcd8bf84e13ed5f8f760b9599afd2296fb9ebbbf7f64fe762c77ff1fc3727314f3c0361fb3ff1cbead7dc14fe3db8ee29fc7727375e209ded6f44187e5eeb0dcff829eba81e9b6747a6bb312254ef3f663dc5369630b3be2c4126de9bb181bcd079b5b7cf048aa5c013196ae15aea164f61484f3edf2191b417b0f016eec14527b1cf1e923ec3117d8bae6bf18d47d2ba39436b3cbb1218d5c9de45840e58c48814bb738e385c0af71fd0ce1d34014f8cad3b9481c05253640268ea9ff1c3fe63e3de2a4ebbcb5c3a0351e050fa23058cdc321e5a488cc46c3021bb0327597ccc3a77021a0dc161e7d9c4a1b7b71fdc3e6cdc7b2d5fcf945cd9f4709ea3bc2a07447c3c866b8ca1db5e58ecf797a3fffc7a291a19c6490db022ae51465b6a01027e4222741812ba085cd220a6b276c7983b18e4f987535e10bbc180e5e16f1c3c8ffbfffe447adcfc608593a16f9845139933b72833c7e684f685aa599ef2cfb9db9fff39e1b50833e33788f97fe757bd200a607f4df664fdec0931f28a63f18b0d4c33583f20ecebc7fc56b16e586fc1720d303eee1fefe8c1fe4b1b3299d4df9976cca991eb86c4e6c611099faf603f3913d78e5b034752ec58ba7f5625fde29878317f98c5e241c064ed9aadd937a3cdc575ccfc241abca1392b779e2c3282d1e44a13609cdbd0eaabb6cd4af01ee4561b731193e9fc5ed5f8d41a59ba0524b06b42b20db583c0d443e7faed5b393f06bcc68472a8cf6f9960d9d86b75dfa476a8de9307005ff5e1c49cdf368024f59a3e679f251f87494b3754459c220ae4d52abfe69e20ac1065f90b50e66265ab6741c31291a7334399a9df57513b2dd9b4a2a7fd31e6e6dba6b93efb0b2efecdf5d1c53877e5b9bac444c43216a73bd53b121312d154ad33e556d1e0fe566c92e735885d861b07fefc65cd6f5fbcfa3d8ffa1f68fedd4d4c90c72bce0729b71962281c4493171c261e694c3dc32208575aea5683164df9a2a94335e6e664cdb4f9630a8dc71f6a61e319b6fb3c664ff3be632b39cd8f2bdbfa27c997ec26abe173f18cfc1c3578d274b7114dbffdb8d27f3771acfc1034b1d0c559f1af4d9479abeffbcf16c5ff1f3c6f328ff79e339a01f288ee28ec6b3e987ce7876c6f3df309eefd5c1c986b67a525a374b9168e98b69b39c3a5b9a343a3dacf5aec3908d1d8af7e248dccda2a73518ed6d47a2108781a5650c5b1aacd37d26d8809193906db3340bcfec4d420796de0f6b9b64e95c8c0d7fed4e41387b6a9ea732592973a64a55dfeb68f3927699d8dcbfa596a21ce650e6c9175389b6433ab71918d8a3fa396bdb4eaf6d4621e2a8bf71057a3bd3f77698216b2ca0fe2c55aafd3b36f7c306a44ef77f0ad5783051d080578939a8ed902b90352e9fd63386db6001bdd8fa2076f51d71cac7b599903a9d33cb61613370692e9e8a59ed7be868e31ab20fd4a72d183ff9b3051dd9cc6ee3a4a0fe5d6283a72d4322e254a9667a7d5f6de324286d6c9321ee656adfa45eda0d2b474091385588c3ca9be770b9790e39ca298f763c340d44d96c935fb7af2fc6dc0f65825e148dd37fd4fe44bdfc4c1cdfd60791254ceafb53964e93da5711c793fa19b7e2882a4c6690dbac54d5bf5b4a3252e2c5f0d8a63356229821b59f55ee651a7faa7e66871d12b3acdfdf0d5c012eeb7c97e12993f10b5cfb4d06f025169666f9f8f3397cfc59f7c36c346cda7f562e7fceca653d1ed20beff92c8e8683fd3889b18127d818123b51889dc00c1b209c87c387435bc853857304f496e6ad197746e4af81fab486917c1a43f190f28c2171ca4168330a373386819342e234e35ef2c0a2bf9b2ffadbb331f3e44ec9161b60633241602d5a3938ea97b368b2bb24878d60eb24a872caa3ec0e967dee92ecbe8f4ecf3bba5cb7ad0f4accf0eddc23fddd2c8ad7203c8d89533b34e36b7fbfc9f6d53caec7cebb77783ab54d4237efb8afa3df943df98fa13b05859d0c62bc2f5fb7d3abf269fb0ceddc96f7cf20afc1a25f8afeb2f51b0ffaa4f61d05b43619429a763a6cc38ca4c0654171a4e83bbeefd31a9ebd6fed1fba092133035196be6bf48898e0cc16b4fb593878b736a8cbbdc8d9e0dfdb0229f236f2edb34edd05f98357c7dd335fdc621f0ce8c1dfba25d26eb173ff1c875c9f621e1e29e6d37beced1b7e7a8ffd28fef93df64786a5fa83a34bd77442e7d2752eddbfe1d25d50066f7dba5a8fc28d6d0c335b40a5cab6bed96b5d7ad495a5a5b77e8f3b95687c58c7eff36bbf401148d9ea5029b3c3d77b06622a6d6c56995886d8e8f459f878d2f97bbfca34a4141badcec70628c178c29cecd8be9ebd2fd5d60337b64e6fec24abaec96909aa6c6647b7be6563735a5fef8dbcc30c68278144d5f9edb577ac6d3236a4da575bbb029f2b028a6c560a5c6150b66da694aeaebd79ef61890d85f21657f2136ea3247c6e4f95661f0333c1d834207128b2d658376bfca26b6d9e0c24db18e6960e3397df1f3da48d6fd31e35bc6edfc466a5086bcd9148bb37922a755d999db8921dd7760f353efdd5774950a5eedb75a1731436a48d333aca9ef675f6f6d5d2b9c89ea2d6d6f3afc71366d0c1877b3b0eb6360bafe6b9c6c1cf7b33be6adf4e40145eb4be62e32b8c9e7ce393f6ba55a75e47c9b97fe68e92b3a3e4ec28393b4ace535e47c9d9517276949c1d25e7b95047c9d9517276949c1d256747c9d951727ea96047c9d95172fe42b18e92b3a3e4ec28393b4ace4f97e928393f5da6a3e4743a4ace8e92b3a3e4ec28393b4ace8e92b3a3e4ec28393b4ace8e92b3a3e4ec28393b4ace8e92b3a3e4ec28393b4ace8e92b3a3e4ec28393b4ace8e92b3a3e4ec28393b4ace8e92b3a3e4fcff3e0cefbfff3f000000ffff010000ffffaac47c7a8b060300`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7d596fe338d6f65f19e8da096dd9d90ccc4525dded723a497f95eace36180414454bb429924352b69546fdf70fd4624bb22ccb41cfdb2f5ef0a26291e73914d7c34de7a93f1dc2665c39e33f9d80e830f64e118fc0070aa1c24069bc24d8c87e22d2193be00f85a5021f102d3240c0819208ecd5ec39d34870a9ff1fd4a133deff869ef30023ec8c9d0812e6f49c9f3872c68ed3737e8732c07acfab3dc2b6093c72ae3f97c57ba851e88cffe59c3affee39df35a4d8196b19e33cf088a1e2cc193bca88fee16381998f194ac6ffd8972a108b00c49a50e5f49c09ff8550accc1b4cf14e036ede93554c1a5b4a258272e1418d9549014ba7b7b7ce008a7c93ce4f58a489408942b2c44043a355843e88707a8e17cf0837bf89c626472832b188474262a580f741845b8e9851a8713922c8d2d9843f28f15239d390302c01254ae711789d3ec94468be7900307b711a0b10112196dbb05f16fa0a6e0318f961255411faeed9d9e0aa144129119aa06dcc8c083518f5b711e1c29f9542112c8143b1c0db10611a4b0629f0b8242cd82b009e475aa4aa518838531a32ad49d4f44accb4e42201cbc169ffb4df00d829575d52adf026290850d486a004b6a5e09120e27e0b0085182d5ae4bef4821671b5e59bc40ab6c9eb7da301b182d257c7c0c08c60da56e66aefda1557badb8e38a2ed658ae802b73519234ae3b6176400302350b7a0646b265408ddb3f376c0b05d7c3670db00b1a7296e0168aa5a1330f2961c2088c296e47d2c14f0128db9f4b13c8043223e8008b88fbdb8a5a3a7a83d6620878450b50c05ce68d2202591a00dd112b2a60e6ca2cd7cd5205289aa2a45fe592950edb3b52e5a559468540a94d55408079550a58b557b54bd03d5fb8ba625b3a5a9daa9b00a607dd62f8d7e13026241d64ecff1a1865e3aa5ff87025f922596f5d82265a7e76086b89f4d13c523808a0dca6193dad0adc79c8f2a318441999463905a968301f7cac110afcbc1b959a9d4c24dd9ac0ad2d08cc240b543b8d007102b22f10ec2a49e2f0baa8265a532048ecac1759466584a2ecd4b4df6cc4fa4ab8b22b85200aed4098591e7c3938003bcc44cab43a8ec0944582918e01adca3d04cdd385ad6d7605e1c600902aeb18caa1214e220801002e1d5051f09964062e853c27055e8cf432c3d9299805a2e7044a4808a2010705f81cd6aab1d6696621d10004a09937cddb61fab253e90ad1491f7db1043d10a2ed6c425c40c6a120204234c1154b851c829afb543c0bd783683948310cb9a52c067d22c62395a3409e298f8f5f8800321b9368902c2dba429ac0e48bb4b4079d028e8a05b85a0930033d3497dac9024427379404b2702ab2e180059d209e7c7126ac25927308e84ee96acd23246ba13341d111a46b51e1548814e30e22a511ae7412ecc8a19226337020eb836b15535338912c4a50058ca9584629f38e027514c35492d4f1534870ca38f450c027ea20461ac6e1de61e66581b71be13dae94cf3781e03c814d935201511d0d05b49f358052df092302f960b0c940adf11673352eb75346664ed71142ab0d48862586bc3083232e3d447699f8f848ec94140b1c13b80524862ccbc78e6f43aef6a6bc286f15c476c669c080ad50e158b209b940f6280d23edf494d6b96b526e5127a14ef911305b54ef60865ccf08af83aacc9894621a634342f0879847d52ab9972aff68c0d35012c4f02de1507f2f9780fb8f4dc9a681507b2a9bc1b76c7269a9391cda45e8e8f691c91fce7c4ccd7ca5f80e5d01ce0c0d57160000509a0c62b981ca74760749c42b68a68d1c9f0ae2905e251c499c99bb16f47e914c7054729f90406472b6473ad3a4aaf58b21da524b1e2b144f8534aa0c9ee75d515340e083b4a57f30566c7558a59e980fc18ee784544f2ddd8b17a91ff49459e8edae37a58aa39539f7b6340f4e71443adc5e734290f76a7832e8a111402cbe3f5245631fd449d4aac65f20935813e5731b9229002352c7b0ea4b0c452ed2c140fe8acb85c2801bb0eff2cfe18ec5116620d60acf93158c085f6b1d29227c7aa09696e0856c7aa493c935885c7aac5a28306e33e9e2bc044d4019c2ed341c0bb40131dd63b86825e4824345b8993806812305e5fa2282c0362e43e99cd80f913992b1a61fed4906236180204eb6bd33c9e7bb236376702911f269405129df82040b3bdf14021b8bbe22fc9d34962bf74778766ce447488a14e4f14ccc6ea849ac30b156a4c18283dd7f456182f22b32430372fb1aae729f6b0047388032c4f102598e99dd55d2364bbb0f66010c0001fa523b1b9d0c25269a8d5519a4a40b6b34e3ca0a343c9b5a69d8a0e3a26ae434966ba3bd2ecd2010c303b56274bef48250523417766af836a1f442c08433b836c8fa2844c9976ccf5bae8349ce99481947820c25a125403ad21fb48cc2ef6a4a10e77ef3a5bc4664f0185a004351c59344063cddf9ba6945d68d32cb28ba21c41faaeb05c1284d521f4a6bef8a9a9a7532e0300358fd27b4bb3afc8ac19075e4ca86f2632a525242c8ff5394ad70a79957130e33282f9b38032370766579e8e964c50325e7c6ba9d2839734036b901f817b94af6644857bc4c6d69eed93851085d0edef13c772898bebb62600f6dba45b73511cf837a1b8c04c04a25d0aa08cb83c80c1348011a487509bad6c0b4840b4c0fa0048b98b3d08e12dfc99bb4fc8693218f6f7b589dadb924a855be3b547bea9732f8d7c4f73d2825f30be6221cf0fa0f780ccc91761bb356b4eceb61f10ec88ccb23ffd13c45aed03b87b052034add024263e838df19b0ec7d142b522d2a34a2c096e8409c9d74993c0ecb8763ab24a14302778f578b35a000aa35862e0119fc8787710a498d4861ba3d0288d1941dccf926805b022051e507c5ac20598156b4023828228b3710066e28f55b34a7e1cdb2800506b49bc5863b51fe341b4e0b3591b804286b03c8c306764b8034cf298f9927b84b580d36b0fca33ddf74df07d39d8af64aab7a5a888338691264ba2931694c43e669a40aa3a81369d753fba7c8ed38ed8de6434e34c39286f49e870660ac4e1962f21b306a61cfa5de0456375c1c6b319961d802834f32cfde800c56cb95d5e1cc076aed0542fbf76ef025509431da1c5d1c201a839a3a34b2c81cfd43170019532abfa38083ba8a9442148bbe467b3a4dd8f5d602c20cd0fea9a2111d6d07c11b01fc160d43a76046eeb4012cfa819f49c75c1a4e1f76df87d398054842db92baa793f225fbe1eea929beddd7e71cb24007476f1c6c52238359f6f661b647ee2114a93d3e5a85508424c05960085d27cedd9052a384d6684d276305733d58ed874fe3a22207a5fb63351690fb10fb1e9abe668a8384b3b088e655b8e80a071e4153df20006141f451d46ce08c511f73b82d3ad49871aa86b98439f63f09593a4ae4ac45f9bd21ca5c27cbc3e46817bf363df61d6a947eb2c74f16dc94115eecd31d29da0e9348f384df3243ea1021014d023b458ca1ca9ad888fbd62323ba42bf1b2b8a53e88559a4b2c3b41abf3476734c80e488e54eadcee5b95801cfb12b3513a52656374ccc90367476a9ba9e5d8dadeec5cf7689816cc8f280f40d24acdbe16390e0d7cae0fd46ea114e188cbd62e6e261095af390fe30ed9bf0c45f8816929c745582ea83982c3c7603bd6db8eda411bd9a091f7ae99346e20c7a89aab8b4acf5a41c9080bd4e9b25f8e4e60444f97ee6e94f95238ffc0d6fc00e8532cd3af34d32092280be8f473c4504714681c89dc438244591f4c7f37dfa965a14da9d260de52e933980b6ca6c30d027aa412549095c31e5199c5dec6241a435a49a3fccdf226323b92bbcc3704db68bec4d264446ac497158988cb41d30504d421251a57e223ad3293b8890ab8b9edaec614df3ed7a354350eaf0596243fd12cc5f30a2eaad50ac3ba3840d9c47155dc5f6ea204a7b41296dc944a62c465a552ea69e5cbeb7ad165cccc71cff6c876478202c963d124c16ba243ce174db2a031ad00a547b74da2fceba786781d36c50b21f90c50e861da245649636af9460b50c2e27519a0e00c4bc22b51840514cf2809c24a4b2a2d1167957ea6b4f18e51f5cacdb7a395b0c6aa9a5a9e23bcc608b36593283f3fdbc49b24b2edf336ca3477f677e996053133250b31cc87525a420ed20d02e15b9b9b7f9b97256b4e808a149c9e93374dde12e607644e42f9a32ea4201f999b67906626cafc01cc0f483f4614301d6c69c47f62aeb12f24613aff248da587cbc55167e931fd530c924a645e884d5c29f33b71002a4448a3a438736d966c160bcd62355be632863529f29d1d159a58731c591c349970b6e1498d025769f3674f8a04d9b9165720bf0d35362bffd9d830a7e7e4433a7d0af05a6c1e4c47d3d0f4efbcdf6f9f000a7829948ea252b8a85d45f3bb9fdc2e661f2a05128a30bd862a850114c2cfa69c0ea86d9de51ff375d1297d85673436e32f1f76e6291b6966c06d4d593e7e9c9e63eabef20d6a16b19df92ae1ecdec9a0b2aacbcf90b74f20d6b3c179357c9905ff13671a66d49987ecebec25663e97a0723c9ddf2194ae993aa04af7236de83469338576c515de1d2de04dc315be745db007f26bc681cf94395ccb5d175a80f52b9383b8e2a6a20db8bd49d987ca2f549ac4e67223739a6a92eeb9e2d80b2ddf74ec05d52e3c0ee2f27b8f15860be35ffb3b567ae3c1cb624ab3a88dfb6e1675cf7d731d33fed339ecea7c0f092b1c8c3fed573de1f7dcff842a08f869e6b739e14ff9875b6367703a183a3f7efce839c658b67a838f01259e0118bf71f3eb630dcd85f6f84f8799d5fbd831809ea3c80776c6a3fed579cf490f8cc6ee607431ba1c0d468334e6ddd84f67ecb87df7fc64d03f195cfcde3f1fbbc3b13b3a1d0d87e7c3abf38bb33733ebaa77f36d745e65669d623cd2f1d2199f9ff5dd51cf9932ee8c07a3cbc1607031ec390f94b085331ea6cd829df1e0fcf2e2a2e7fc417c67dcef3993fcf7e5fd5d40bf9f3e3ffa26b97ecff95ecaf5355d940b714dd37bc0f165cff9a24964cafe1d23673cb8b872ddc1a5db3feb390f2a8d393f3b1f5e5d0cae7ef49cfb46e8a8806e0afaa3e7dc7487bebcbfc72c56d877c6ffeaf7fabdfebfd3d6335ed5d65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5adb7bef5d6b7defad65bdf7aeb5b6f7debad6fbdf5ff87bdf573bb694ab1083af96ba7aefb3f32b7a8a22e04946625b749658b4e5fb12fd1940620fb4c26fd1f0adb19014ab8bf8d1860f4bf8118e0cab8f05fb87d4b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618c012035862004b0c6089012c31802506b0c4009618600f3140d999ffbfc311906d294eb56a270bd8a00aaa0077302c9802466ebf852260e08e87833231c00c52759819e06cc30c3028980186c3c1e5e551cc0069268f2506382f5cf82f2eafce87579783b36662808bb3b34101edfffd840086fba14e0ab0ed139970d7f77febddbff5d6cf4662eeac9fb756d55bbfec799fa16b63f6272c2a43da8ef28ea37c3b1c37c3dd81cf839537bced4f6f78f0fa721fbcba57b1fff576e94dd6142d6e07f079bd787b99066fec76e97dff42eec8d5078aee97151cbd22fe33556f93a7643a57bfd6d3f42657f3d7e7d54e1a793cf96d2584f772bd44ec5bf02bb90e5172fdf1f672ebbebddc5ea3c8ff984ede12cfed0753325aa2e1edfc6ef840117ba3885e85a97cae7ebd899e46687295f837d71737e44b30bdb99e7bee591f4557ca9b3c25dfd893f0268f1491d5afd39b2fc174b25ebebabfa8bb2f3c3061e43e257ef4947c7bb9652859fd3afb5dfdeafc7586d01c8bc2009fced33ede660c2bc8c2209e8ffa1b83383caf1bc4fec9c03d71dddffba3f1e86a3cb83cde209e3719c49470e408839866f2bf6010cffb97e703f7ececd21a446b10ff5a8358196c5ba38857c628dcc6afcf033a9df360ca1efa6f2f6fe2ede96ae04fa842c954a546c61821f6203c77447e235fc8fdf7d1ea6efe856446e576f1f6f23047115df913baf4c8f47c7a737b3d9d3ca4c6f575f868f402cf7d0de0e449a1c9538292ebc81b4e833f868fd48f289d4e7e59a1c95abcbabff4e1f355bc796f3408e173f64e6f42c99d4be3b7c9d3e82e121fd3ccc011ff2b5dbdbddc3760bec5fed7fb228f1fafc35b81be3e7e987266e536e96786dfc44dd9e3c76bf245df3de7715f1f3f8cf17f8b2833ef33652f8ce8945d2f9129177be8bf3eaf55577d53a6d9f72cdfaf2f4f7d38b94a525d5294d7d4c1538cdc3fd2f27c7f7af8a928e7dbe469f5f67cb6787b3e9bc3e7a75a397e19a264a05e9f07e46df273aafb32ff39bebf19b9b9bea903e2b98f67772fd721620f1465ed84efbf8fd6bf7d1fad4a388abf5e276f2f0fe93ba674d4bf9bff3cbc9bffbc2d7f3458a2c80ff3b64ed3b8bf19ada6010fa65ffb79591ea9bf78a468f264da237e752945c9f47cfa5515eff992b7ddf2f5c5ffb87b5e87def3ede2f57b96e66f37a3b5c9ffc34d5e37375fc8b7af8f6768f2f471e73e8dd0d75b8a86f77959bfc5f7bfa3f8b7ef4dd89dbc9eddcda7dbb22caefbf8e59aa2e42af6dc479a9779fd7093fdbb2fbd3fcb6fded76fb2f40cc6a467de3bfb26ae6e827ffef32f9c509544edf3a801fc6dd463179bf9d4fddba8c75cf72ca51e739b7718fb66cbbf7962ad4faa876dbaa51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463967acc528f59ea314b3d66a9c72cf598a51eb3d463ff77a8c7fe3f7bffb9eb38aef50fc2b732a8af4f9f530ad6ee727fb3ad60c9265d4a94c53f06070a2e2b5bb52d5b965e3cf7fe622938ec547daaba313340a3d1a86d05922217d7faadc87f4a8ffd537aec9fd263ff941efba7f4d83fa5c7fe293df64fe9b17f4a8ffd537aecffd1d263d0f5df557e0c2604dc83cf872cdb3dffa73b976bf7fcc37a646fbf321613109e84c907c5785e5611f8ef8bf17cf92b8af1f4a37ca77800fbf40be5785e9504f8a71ccf3fe578fe9a723cefefd7370a962d8f7b9dcc35839319ba0da31d99373e1f09c696667ea11f5549d64d3b8c4285f0ee36d3837c3ad911a88d428ee1623ebd16157b5923e6ae18d9ae995bb74264c1519587c263a9dc50661a7b39491eda8a27e7b190d896931b0a7558eac36add1cbe2fe2d97775d9d798310a72eaebff0850e08cf51d023579f681223701475a75691ca8398feeef419d20d79ce7a123247d3da179e4e7fa0a8aac053961c2ad760a9a79a42e3113e4d98936d09716edccf999c673c653ecbdbbd50a551122df21ed3a9edbdd359e34414e4ee1620edf7da40e9bf98511757dec0f5090edece717415d623658429136a31dbea3f4f3604f6fdfd2aacbb0f1b606439dc93ee432c65bcc8aafd6a45097588036778b098c17fedf935c3e7a8efed4cdcb62d6159e0b78fdaebdc9feab393bc09837f1bc0d971af457aa22f33feab29a6eeac37ed58d0f8ab9e1b35f604655a6b117cfa67d2d9da12691c266a1129da978d8af145837f87efbe9364fe9feab35d9ef78e6f7712c50fc6d1ca7e9089ceb5c4a2a1e86361fd6a0ab114525cafa39ee6a34757db4877dc01b8de708c5ca9cff7edf9e0e7397cb95aac03b684f9428ebffeedf5797d93934e7bceb088cba34ce23cdbc45137d41bd6e4ecf7705f0eec757bb5bada5f6ad7d287017f66bdcaeba7ed1937a3f7eeb36768765865a4a5fceaaacc906435c55a1e7209e9750bb2854b27ce7c01cdee82b683a7a8b1ebe49c94eaa225ddb5def0fb7359364d36011acf5799b5dda5556edb68b285c65f561bb44df5732fccec2559c052b7269bfb6ccffac39e6f7c5fecd71b5502f2b5c1a2f6873328c0de6aa2b0cd8868bf9dc4ec36f363b4726d1bbfed78b2fefb5dbd3c0e3dc46411eecfd5caee8169d16f18d8675a22d5591d9af5921089ac361db7e2957f2c55ab55fcaad28c3b574dd1e57f0cebaf9b2b753b234c87e4ff36933d0651d28ddbc263ec756d411981763b788ac69b63c152d86c5862dd876339baa8b2f87955c9fb78b2fa755ac97df1651b066ebef0e7b69b7320e57c9345865f57965a667df096edf2a65f9488f1e148134e7279fd75ff29b6e8d475ea32af2495548152c0d4105be95cb42bfae59ae2ea2ae7614f0ac6e3f37b3e8ab05bcf68e26e2f45aa7ebdaa65c85b0afd525739b1ba099ed6c1f76fb049ffd8176a17fbab8a343f3631a7ca0b92b1f20ed8db784fed69c4daf63b83edbb561d36dc4c09af6df23303e3bf28f7db92eb42c502e91cbd9079b354c93ccbfe9cc145b36d910191dd54550acace3fdfa016fc7eed6483c85b4d7765923db2df593efc88ccbed0f40477693eebf2debbdc3ded13cac17f083ad0635e21a0aeb05fc1e8a610e7b8d1624f10b9031936eeefc186a97196c904ff6ae33d95387adc3650afba271b76947bf9ee30e3c928d42051f80ae7da853c61b0755acf7e86ecdd4c5adbd6e6c5d9d2fe071b3f3ba99239f9bd6c0b3827cca526e3facdfd0aea38feb04326c1f2c49eb2d6eebd7cb287bef3902e38ffc07bed7843e31eb43bd3a456e5c476e76e6e4ed795dcca6eac3b7601f11e614f0f3a3b7d5bbb9ddb258b7a4cc1ed6a6abbdb7522e19c8425813241ef79e39fb72dfce3abf647e1e325e77eff8bc321f0a8e0e6308f65fcdbbb1c855b9b586fa6ed0562334743b3fba0ece56c0ff9a1ae6fde88b87bdeb08c27a3167fddc80da8289cf6bd9269e477e911e55e51dd9755b13e87303322968843adce2c3aa7fa7dcbcb16e30d7e3f56f667ab7868fcf583d8ffb7d51743408eb6bbadbd0f479c2503b8afcdc38429158e01dfd3adfae85bc56864b23f3e3793ceed1aed62107720137d481da864231ac6fe6e746ed73d9295c4c56741bf5c56995ec142aa4a1b99c50f3edbe47ba5c41fdbbf6b0b773d206caf4147044751d218522b0ebc5ecb48e059823d8f777d82efceae665e6f286bf35d327559e373ec79601677f790b6342dba17361be26c7d5371df0dd97bd9d4fd950b9f5a52ad988e30eb76b9dec8cfc783eb7a5747f6dd79ccb6187c1262ba079bad572a00d55d6cee1d6c84ce0755090371e696d76a03dce7d7a13e7da840399b1b20e7b1b8af93a594badcbdc96b2a5bb35b2909305b8be5b6666bf9ff4ffd9d450a3af5f5bbac535d0f2fdfc826c0b15b9f48b718d075cb544bddc1ad6bc5b4b900d302e737eed4f8575e1e7c053b3f5be845a8a1dc67ef1bdd7675e7ff3bca58e90ae14c0a6d213f0ff9d99befdfd23ce17873db7c440575b6f6b1c6cde88c22569756e9a52334aa0b6e1269e9f42a811b9558fea7fd9feb8fe2eaf65f0ad764e2ea143daf0ee5b3a5a50a46b9dcf7bb934d04412e4043037c8fc485dd2c857b2f4ed3904fd65b27ac0361ff4bd5a62c6dd1a6cc0e03355ec27551158dfd1a010f2decda7e9ee0eef01bd7c44efd71a954b2d0b97a4f1e3f9efea63fb4755d1ce7409bc2aca5c077818baab6d39d4a0ec6809e89db5012774b5258b4ef69d418e426dd36b1dcaee3d6dee7218ea5a56fa58787ac1ce7de5720e1bf66b9067c5580f7535d60c5dcca7dfac37d767e40bc39ed747cc73a0cea5065abf9fdb9eb6eff89422edc35e77e9f44f551a6923dd07e33c0dfaa06b76b891a57ab7af184f9119551132ea800e659c5545cbaebc9ccb72cf21e9fdde78678d5f8effe0022d5b87bd5f90cacd81cfcd53776b4483dc2837f1fca3bdff1a870ddf4b81ceb7240b78e3c613863db35ecc573633ddac8bdbf87766d4f5bb0259520086fb72069dd0ddce5ff0ebb1b077bf2f5ecd77ff4e7d2717066c33cddfe08950303c7ad0cd9b7bd971b7d73e184b877b787493ad6c15ae17f3ae36ecdd3cbe23335e618001a7e84f6fda0ce42a047c71c374b39e872ea223ddea8039a6aa38fb5d55d2fdd75ea6b7efe38ed9fe5e7f06796d724480f6e1ef80bdc31e8b8731de1559eff0caddb70587b19d710e1cb0673055b623cc1d96e87941cf9306bcb1bc5f87749c97e962ff82c6967f82874add9aec7d8734f46617e9f7d4f66a1369e85666e91683be7c7061feee69a3a39b0e23034da7c3de3ca9f23c0a957dd72fd85d003301c6089a39ef3993bdcf53781fc6d1ecccabfde1c5b3f53ee4a2d2057ccb01ee9e72c03f7af9d8f1c1932a8fb8b51f63b7276ebc1170306078ce75b2a3eb68c78ed6477dfafa1c1e706367f3e9df5962c0a947b7fb6e7c867dd4f173737e7e63dcdd77f63619d08be607980bbad55a773107be73a48b79e23a93a2a7dd1e9bc27c528739bd635b18641631c76f03db42d044f77be6a82ee19e76f71be63dd2ddad06fa170363b8debbcdcb4dfe76ff5ef7d715975a9cf69d3a9859e7721b7059e1c7d1efdfcc41a699f5bee35beff4b75ecc7f7f904fb7bf3b5d6bc733fb6f230fbb7dfbfdde7e898f9edec62ea32d6ac420fa93ba881e787367cfbae7b7431be3f7ad44b5468b7a4f159971cd74946d1d1622b09ef20bf9bef821a6d97fb5fe843c31effafc9097aba3fcbca78d4e970a792d0a9673a03f90af4d2703eef1f0322c43650fb6bfcaefb010e886f839a83b79d9d37a4fd3c33ba0f34cf64489caa0011abed4c0fbdd4e06c9608b8d3a9c047b01640ab7ef79443e2d017f03b60841ffe00d96e6ee0fe9fac5771eae7c2501fd42e65f627ec04faff480d798290af8ece4367f3d86ef6869ecafff1fe671188b3df0a5bedd913fddcb105f21e3f5fdd7abdd72bc3f2f697c1df3c9dbe285bbc5194e0cd9cf81f648fa522675ffdfdacb3a1d47fc72eaf7e8ecb32a9596c54c4ec05303ce3ee805c929e097bce3d1d7bec63dade65a14703687176ab92e8cb33de2d605eca3c368c3bdf6fdcd9c67fe1267af6c527dbb9fd7f930368b99aa0b6de495f10fbe63c03dbd5d7635cce7d0d60bd908fb66f87bd1dbfaac4e960d6bb11cf195de61cad0b9cab8db1e01becf0f7262a965aea3dfd97d5fc9f4ab9ce979f16cfac05bbabe8c01ef8c387d187fd1f3e9714eed17726e5c8b4091194f064c0236818e7fdedac8af7c14e6e16e7c9d4d0878704b5fdbe9badf7718709499b9b7d5aef61490c9d491136f71e31f2fe736e0a273e85cd21e5777f3d8f3a18eeeeff8b633dbfb037f1df4afa1cd9b2d3bb8f245fbf416cebaf202f005f160bffe91adfdca471e6964b4330e98b7c3002f71b2c8dc304ddfdf29e0e04c04fb80ccfad5bdd1660776ad9773448027dfd9dac6332780fe3c67f0fbc4731e746598f317f69c37e762c044dd383b9eff12a3f7e33a0cf22072f3cbcb7b4775f9823edf1afb5213ded81f277a6fd332672c12677bbad51af8ae0e57f718220b0aed1c14e8e11bc2aecd079ef942179279d7c998bb3938dc68e3956d096820f2b7e8251fd8c3191e8013578a5dbed55f87dfb9e9919ac2d1e782031dec71f7cfdcc9903bec356291d11fb987ef3faacbe3dee78e4faa4223e081ebc5bc82b32b5c27047b62b6ced92ce07144397bffed455fdff4c126d8ebcf7ff1b90d7ded831f46615c9f1a032f7866cafeadc7c24dff8a63e1fa51fe0dc720fd732edc3fe7c2fd4de7c23d6cca3f1f6a71330b32032499c52f8eacf97d9167d500b1afc7e774ea973cb27bfd78af3addb705269c8ed536f7edccbeab8adcf6702ceb21c5ddf9743d5464ebe1fa433887ef4c1bcac9a76bb8c6edb89edf17fbbbb08f8175bf05f1d76fab7fc71f84761cdf569d7a33d87af19e2a5dafbe99f33cc8a795ba504f6b0ea082d1f8dc05a0c137f77aa65d37febf4685b942df7b171016c035dcb9563a156730cf2c6a1093e04ab9330fbe7449cd0697259b057938c2b22b4ced4cdd4b920503ecfa33a69bb720c8a052bc0f7761eca6d09926fa35944e371341776f14dde3efab89e42d1805f038e046956a305371eef0fb0601c7301b9a67cd689eeb5db424023870fddefd8bf95ae283cf7766997884cc1d94e9e7ef0e9e927d17f6d2872275707768b353bbaf6ac6b0bfd6fb37a0a7f21a6e0ea148ca95a63a9846865084e80abf56d6f12d35840f7238ba4c8efd3bdabaa9248ffdad0b7cf41c9cad44e9f8eade68f65ca4bf2f5ece910c7b6a0c0d80f10daea8c17de26e07f737984960ce5f40c737e76208cfe9c6f9c19e5d7d00d74793e99b74539057e3e85d012198f35eaa1e8309e641d5eb4cc477eb2f0de6a48f4c03f7ef334171efc6bf5ebfe36ba00648a0d28039a50cef54aed51d2d5d217c5cefd771afc6831af08226604f766e7f7511656fabd6dddc9f7dc53ef9cab40088bcf973e3bbbb36ae810a7be24465302d4556a8c84cb8c52298c456a2dd99d276cdfcecb5873dcde563c0d94755b942e12715c6e09036e0e4829af3e9cb6f794d835748fe67d6ae81908a607041decfa5d13d2bb40fe37decbb7f17be6d113d9a205eb7d3f1b9d515d6cfa7bd7bf71db7abd49f856a0fa18bbdccb3af34d2a9fce2cd4d38ae0d986f3d659a82db8d728451e3f91775a1d53e8faf7f875b3d5697f55e2de63d0df4d753ea5cb250b1fb7b9dd960782e9ff6c7251668f80daa9b3a7ec7950e37f19b6100a3dbe97b1f1a38677c663c120febeed638f4b406c736420889b40f866fedbe71313f7a4e95a98b290b619c4133fddd738c69b73ffb3d2b8f1806e43db80f20a46fcd1370a5b4ebb62ce962b237780d5c7c82ba7c3093ec7d257ba6e6fcf7a0db9be9f337735ef5a12ab77d1f2e6e26917e4c93d7a1837ddf6006cdd465af6a0fe301d5f6fbba7edb85182ad3ebf8edaeeda88639b90fafbcfbfbb6fe5797cdf8cd234dce4e414e6a5f81b08cfd79bb3c1e1c46aa9c5caa76d934bcfe9dccaa0d99f6217cec65bae682a3ba509f34513aace2345e0dea66dfe65d68cc621aec9ac8d74dd6734df669cbb2d7bfd18211b62cf37d451eda8b5783cbf74df7df880dec477a5f0d7318b487bdd59d497c195d613def2755b8b20efb1b1e15e6c11268058fe692813e3b3397d863b92b367838c273943957dcb29dd76036b9b5bdbf339d017fc137b9308c175c17fe729edd4c19f32858ce4ee116cc910fb8f8e415f8ecc7fbf2cac7e048d185c082b9b5c34c643cf65260834ed60ea6dce511be9701370375f427756934a1d3990aaebcd9ddceeb7541c0255cf9bc56b8cee4a0e64616c66a7927fb604efa50e3de0452754783dee4fc88f122aa1819bd7769c19edba67b9f138e3b735e80dc7b197ad2ff9e32decd1c08fd5df9c4d5bca54499b755affb9d2ad393c6ebe3fc8db8054c9a079f33fa75df7666bd66f8fb44f3ec082ec2d5221a5c193dd61dda3c8dfc505d48d3afa08b3882a0c6e9ef2ffa48ba3d2fc9477f3093f55863fc5bc83de7c2809969a4cb95399b7e8d47f789308c511fcdd6e0568b6feb0be6ccd9a137e583b97a566f1a0867abaffc7ac0fc7fdaa53cced957f3e5fc60df23cc9bef5ce742645ecf0fe86f1299b8dc85a58afde5ed3d778cafcf67cc71fc4e08b91afb52c134bc9cfbe3735b337ac5efc7395c77f2675fdeeb5a2f64c7611ce71593c7375a1f421b3f9cdfdec4174158ca483fd7f049588355737c61fabbd2c4b0b6b32b56d29dcb715c2787cbc2711c777b38f295faf0064f1fe9a4e3ed2bb3be73f5bdd0f5c6b9c9af7370e3134b66f55a0f9cd73d8ef8ab8f72ed2a49ed9effe33def8f3f340cbe78f66a1e14be707fe721e95f98bf222fab1fe53fa7a4ff734afaff774e497f6383de8c84a0d41aa0946cb5d265005c6326284812c219de10f7b8242d059f656fbc10820e084499cb559d81c2e52e51c0e32cb88fd91a9e7bafddaf72e7ebdedf8c6d93bdd6c522829034cedd79d4d6614f16f3e943fe9582a38037fa98c331d6e01a9727ed2997715dee52e78bb5c7b886219700e29a8c087c62fd983b3f529fd725cd7be391d99f993df82ffbbf5fe6f30cfdaa8a5cb84e0602f6f4a28d2ea76868a3ff5b99e683d120f115b90d9ab9e0f3f6e013be337640ce4b3e6d7aa353d6be9a7fe572a65c08f3feb2cf3ea6ca84f7ec7d98cbf077d747c8c9901bc6f8cd9003a3c80cfd38e728819824d2adc1c5fe1a47dd37dc2916c7d1e8778dfbe9d7fb4995c2cccf49e339a0000b6db8d4589737ce4102ed405cf6689c0583e2308f43cc570704877655854cbc2d1e7366bab88555d70781b51cbe7536ed72528aee1cf6dc73848c2ed4525d5ebea88be8e119bf20477f91de84779fd7c0432c67d8cce5ceaf18477bcbc92016097cc8f52dde48dd3b8df17b9f27c486eb7c56bec895103d8524de4887f7316ee61ce25c205efc3cc4e63ce419810f5beff6d21180ea35be61a4df01208eefc61d88e86221f6d1ddbb0fa02454a2c6e721af89f0aadccdd9f5fb8c6d54434c12d0d3d847a87cd9bbb9dde5945c7deaf231dd71907740a65b53d80ff91583f1e49db5bb1931e19b4bd8efae2340bcff2d46601b3181023c80a4032fb9e5ad2ca4c3eabafe0030c7f652c82301c3cac9e5baf5efe669653ee4b2301e80a1667ea2db60dfd1ae0d7991d366a5cc416986f9bb7ef3fd7adee2783b1aeed6bae33fc35abfca67ba2ad3d7752ec1c87737a7dd375d956ef1627f2d86fd728bd1ef7898bec56078e814cb316e6350a69887dc4ee53e7f53dff7e3bb3758430c1d69c26ebd1f633116457fad9f93e139d8e7d91027dbcc1e62677aa3cd18630e8e89c3791503ad1ff6ee30deae2d730eb94f89c72350942388f318f942c7b780e7ddf3e4ee9db78c21303ee274fb958c63eaf9d868b0bab57be5a3036f36ce77fc33fb8027bfe48f57dabfc54dd81f1a5f1ebedd027ed6c723411e6ce8106b98bfa761cfcd3b9958c0bbc278ef8dfc9f7a1ccb18dbf7ff1e1ef9929748d7fd84bd2d6efb75b93390c5735f5b569dacf8469893bb481f79867cb74e6f7ecf4033431e51bf673b834237cf0ff97fc33bdd5a98b3a9faf2bb7b7ed5cdc91097d5ed3750ecdfa6b7bf9997907ebce31eff4618886f6454253c878b6edefa711378ef0d9e7b8d1b997d21a270fdc631ee7a34848183c6e2e71d3f719d0b1e94fdb69f37c03ec2189fd6cde9bd71a58b0f7d8c633e0790870b72e06658b95fa351e1ef9c268bfc21c7ebfeded76b3bd2cdf033ceb7cbc96d28def6d3269e2fa92364419e25902b023c207430e3f3ea17224e2017c2061e1576862d5afa394947e57ae417ab457ab7c7e65fd4e51dfd8f39459093b6d8977771b6fb6f43de1e85f71cfd9603f1560e406f74620003be13d73e38d140d60defc72fe3c85fe63abc3f870306b8ce5b477377b93fe31c3cf093ab510970b30ef37718b1f547d81c8ca5649ca7eb3ceeef8dcb83d1685efa857e58dde5ae8ce3f0af7458bfcca1e81d04b97cb406c34fd08eb43ce0fcfbe79b1e43411c5487258bf4758e89929d200619d6feadb9eef0487ccd0f021974c7db85768821bccaf2be9d6b8003c41fa71ed45cd88f350be6b3de59c6f4f9e3835c19febee21d7016ff19397427c71b0a7511e22f6f1bc01fe6e587b8fc9d7c9751bef478c650a657e7fd381eba8dca80d73f2fe2072317cc0f4bed51eecca6f731b9e3bb6a8f59eff751bc3267b976e5c5c33390c7a6d48fc6f23bfe30e46d75f2ef2e8fa8e31123b6186b758cfbe8ce19c90c340f06be8eeee915fb1af69fe63322f33ff73a67b7377a197e9debf5e24e5675b2ffe6fc1c71feb84741e77de44d9d61381ab04237aeafe3bd767250c7dcac463daa2ffabd198cc7ef057e066bf2c8d3be5aafb0cb78ef7f560b6dfc3b5e2fe61d3dadc775366f0efb97322668ba5c934e6ff021c8e25156c43b73ce0e7a54475777bce06a58863d1de672e9774106d7185dc0c6377931e44ec11adce7bfdff3fe2e367c703450e5cbdecfa7cc0d1bcd5bbf81b180aeadef77ce940de2f939e4265d0eb3e7d42f1d08b7ef100f3f96abe2585f6334ec02ade84fea7f21cf3a1a1bd6d4e8f69dd1aae29757727165ce9e1ee4d8a2bee2347571e5014fb0feefb539d2e16a91ee37f183ac1bf252faef00e7f206f02460c3f8d5beff3cca4e88d9bf5f8b31e7f39b757c657cde352fe9abeebf71515fc7b5861a3650fb47b9c90875c97472f82f345857c7be3afdbf932e32f1235bf5e3a3a3a95a98327faba59afd2b2cd5dd20ff3154ff63a8feff8ea1fa71b7dd6cd4571e9be3b3bf9d77b960233f0e1af5313fbdb369026feff236225f3232979b36ee16b0238a37f11cecdbd95d7e7a4c150276d96608387bf319df99a6a173e973dd611c0e7bf6f3b255e33b3e9c93d61a9e33fbba08e760a18ecef73b1eab31800de9568fbbe09b2de21edbb9946eac3ea9f2dd772dbae71a244ac2c3b3b7ebecabebcab4520719ae2ee6e1fdf7b8ce05ea21b4bd4e8a19f82eb0d752f3ae8d5c389b0e5bfb4a96785b630e0edc8fe606028a7deec276bc1bc6bfd04e1008f530ae023f7bdb39f21c6db09bbe393fe0d8943c08008e67b1af64f1431b5d1e1921415e77f7d7d79a01705feb1ca7afe862d1d5167839a7fb718c5b8e3dd32539d22d3aaf62f5ee9979786d7bc90c3a11d3f79783ded139c18166fcbb31b441deb5733fae12e4ed6e01b5a0d4d56d3db46ca7e063e8181d6d39373c11fbf934a5645af99cc1fa506708d65266feea9c8ec67b2efe0d92e16359787b6c9483dc13f3f4fbdf2a09b9bf42120ec37c47160a4fff08c37f84e1fffb84e16dbbdd04a1dacc6d53ca2cb5fb5fdea8924c88345d1ab620dba96c1bb6be37d20c19e6646f485ff6563ab555894850208f4891663773c5b42fb22a199a9d12914817bade1f1275994500d27d659a781c2d7d300624d26ab13fc47a6f306dd7dc7da14d79b64df62764cd4e38d1e30d30a62544656210a07bf5eedee00c697deec2819344cd23265ccedb4dfce51ce4a4e814b5223ded1cb9f18bf9336d84c4e798f3dbfd4ae7b5398d421e55be22576e6e446bebdad72954c227b53562aae018b72aeb3a24df5834a68e5bbb9c7a01414f134970db30a339cd9163e428d1726a05dd3841f1a14e5704131c2e07d7b22f2866919d56cf282386cee212335a6bb5c4f6156a9956941049a82d267c5a31ac8ae3ca7424b20d97c4f3b7d18ae659623be12464083553a334595a064559ec9c4c7539bbb6c4b982257cd159ca99acc1bbf9654eed29095b66fa38f71015985643547fa32f0e6fccb97442567a420b75280086cffe926674318bbda5c1044bf4b46ea60d754250329b9d29082e141d55aa620d80c69cce06c7d2f945160ecc791791bcde8ed186cc659d48c23a990d733ee3362289118718cc9118b5b31ae734a78e7e415698504567b12571585139e4b81324daed46713f9af3edc68a56416ad75ea2ad711bb5211b30a1535d2ce792f96d64626e7f0e242a13a5c428358c8d35c746425db3d54a2c5dbe623968292bf1c8be307819b46e4e2b94128e6458204c54fb1211c3546503e6d8a022536c8b99c278a842ea3e6a5248c0e8b71901543a1acda7602c388131498d67f10d2c68d7f9eb04b7a9eed5f8103fae233efbb9004567660046a03f30aa041c00a6598c9a09b34e502fe8210a8e9b1ec39ca4ea423b844ba30edac3790dd1c95c060e3b615dc0be156a8f0b3a03e89a793dc675c39cc110e577466ba65d27fa09b568583774c18954bb6d5a238724ae65f3885327b8955ab7452d6ae7919b238e5a34de382ebb11f72c163f5a37a65931b4f1d888e26579f497a56de574152a7bde4ba717c38ec415474e8174c90c1e1f0d27aafdcc987b5cd0203b7cb60b6d4b12c30a1899091c7c08659d710812d69cf0d5903482e2a9470bbc702ccdc5d2e14ce53daffff9751bc068bf668bf8b65eb7e85d161c9c1c35597038339e832a501ea83dfbae26e0b89c8331b505c5435da8cc3a91f87522317fc17addb2ebb6bd9177bded0a83946ec3764504611d7f384e93b98e695d18c52ec689eb68f9c69253ea4054bbdaba9c5a6345e5375694a11ce73449eb8db8e7b0624f368eca216bc675df03461e8514419e315040c7532416596a15dad4b69621bbb10c9b6644b188717624a1b0b3b2f4f8500f49995abc21511eb5019f2d4249d68278fad551a68d25cacf66211333d385c0c6c8cf340fdbd5c262c3dcc9e4d263a5662755859d688c9f1adf913d2d0c499a7e850873c5c80288c654a030480645023fde77f150ac6cdc7bbcdcbeb75f835c3e51cebef2d70f698499eff06272c1cd44789f26d2bf40ee8d91b008f8ef8d3e2cfd84ace0b4310586f28784262447b991e35c4ea918d488a3394af60c4aa208f82ecab5943a68e25a52bdb1e4182912bfe96544e9174646bb028fe93ee0a2085b52b32658daa54473d2eca4b746eb6de9d2b429c5327e4276b8c4e94120f634b697a1683a0235f3f2d96fb5efbb749aaf99acda2da6a9bf9cb3be6373ce32e2bd44fe6aa7f50415c6c2cdd90d56b06e4899e431979830d1f3c689f8af162856f39442004c6e647e8e4bba454fafd6f0851c7c6f3d7fbc4f7e82463a63b05d75d93f4a96bc4523be9355eff08a0664256a7e5d36777d985faa35c796be6357289ed4eb64e0e71c9a6c2cc03e8847899cba49d0e2241536960ef22f41adcba21e07c598a3916b1911e2a48fe4f02adc2221680dd150b44bc84d13b725b5c5c8274f8c2c0f8a71a56c624b116b66d913c9b14588cdfb4bedb0918412db116b6e651771d3d297ea092e662d552e76901f6b6b59aa768a0bdd9c2e6d2b64ad14f338af1bdb7a897d862cdf74be43e6445827ea9bd867634e9abf800f8f9910b0e7c6bfaba1df4166ea2c525c015b518415bda5b95ae324655c0bc7ae35bba05cad370e9a001fa54916a1643fc18efdd11c6774692c2d9ba4de56bf6091ae423ed4e83633a81da9b4cd04d3d619c2e2b32fcbfa6e39977d5e537d7bd29a3c11c22511cd657642294156a1b2641b091b7014e64269e45aec716c61326c63308c805a1cf91659d87f81ccec300ed07a5cbf7a5e9727978e472e2617b47803e7743c0cfe9ffd057c5280c298b05e5ddf6b4b021908ffd7bd0c44ecc6b227aeb56fa8a55f36a2dbba1c4d702be7284135e6d416b7fa845a88d95832ac618bdb3dfb910c3413d450259ca03ce2374bea06198e43cbf07c991efc44def8165eba39118d54e0514ab796185998c8ebd0c1aac1a6b5cb679b902b53db31beae980aebd98109f979a5b7e1da932ea2670b2d558e173ba5a6493262934e06aeeee6f912306ff2196e9da4276cfe3a9f09b69ddcb9040d33b4d9cb1b2c2206b7d2c5cd8d148b59e62624a3962b5031addd564e711226a8b519d7caa28d42123791266efc81bc31a72b53a91c73694cd6ac269334a849466d9f094d83d937ab566dbd25a69614a53b3b7836788df359b2f4a5704ec5b91ce432eb488610a61163a7e919337bdedf96c8372b464ff15ab7c29ae6b8f658e9e22b2ebb513203e4cd227e5ffebfab5fc593bf029b37ae2314f451bf7a790d784e73e5eba0af5aa8458a16610e47ae43e38db5af37d63ca60ece36228db043322c82be650b48341294a30f794ec85037d86a86a95c94800b132466d86cc9775b24a59d845ce8b0ce9ad1a8bbcd367a4b75ca945f517ad1a91571412e6c8c22fb6e3ba5152ce7b9e10885498c122d658ea615b34b2947994af0edf0406d8a37847cd55ff275fe05ce7d9b9e41cf6cd7c9fed7f9c4cbfe9a2fd5eb6bccd8df30ef7b165952b3717406b5f03f4e703b9ba0446236a2cd6305745cd4e004a7d8dab748cc928ff52316fb4c79b0591932191d9fa99fd75cc406a9ecedd2acda2cc388a6d4c4b9fcdd2306460ec3e8d6fc394ce8dce088a3e7351324466db1eec44d4325c8cbef8125b7061f494411449f71279ea5367e3b3bbbb6cd3a1216f43fcfeb2b709abc877f1eb0f5be8cba82be4ba3d597930bd0296edf5a3b74c23f8f79ae7d80eee33a38a1db391334ccd0eec08f2c747173c03b2e8bac30429cdab8897ea1495748a9410ee25c0e5db04322d75159248609fd08ffdad97123ce8da0d5b65e961dcd345b5845b9f1982367b2d9c59729268ae659725498cb7de3b37a1dc8f699e6a5692e33395854bae150d996d3da4ef056e7c3b9c1d2afa122b33b657ff1a4e9c4ca438d26240e9330b5b7f3f9c7f877deadcb7adbef1b75a1fd0f8a61ce556674eef839297cde283b27573c8bd162c2014e5aec4b08a0180a9b75f8a95e27c16b796c028694ea9fe66d505c96ef6d43dd5a41a5884e97b2abb1ed411f8d5d4ee7709b5ea823b1ae63d71b2b68b0635f3067b3aea30b1b717f41ad915051afdd04a71b4bba7c248bfd163201532e94842596315a33f4abc7e0b99550739797a5eb549eddaaac9d5ea8516889b53518b39063aae8133d0b1b3f959e691a3e612b2cad84e86e32e382255d183c3d62273490a4321bc558f94b7c7496f44c94e0637d74896b08ee0915b7f20ab4570b58abd909c5ea6a910f076d38f4e87607ed083364c27de9ad3581eb3fcdf78683212a9a5fa2be803fe80c2ffb9f5443ff03bfcb22ecb8dcc64a6b0cbaa263f3480c5ad4aa139a68111267938de54edcc465a823351bcb66a9a8bec3efa4ca776a21502ac5e0f76cc8e127939daf298357ae7df12c6e5a93dcd000d73c541dbad2a9fb0e9dbabf3027f7d58dbe542f6ccb03adba832ea545d8721b2c061394b80c4e74ce4dec0bb5b2d46df717cce93c1555963a12839328a3e2ec829cf7e6a2e3fddec6b9544eaa95869c655e517eb748c8da044bce927884912ffa969c704197a1186ef5ed7c81b66a4d9388b124214752762452d89a22aa039b6c6836bb786c9411458b2d27d483a57e71b3b94c6dd6f3b24c227234f911ceefe6638b53afb9e3f9102cecb011f0a1209ec5db049dd062d0ad16b3bd57a06ae7c895bf18ee59e884cce1dd65d855890814398136b7890aefd66b47cb4205ecd3b38e2781fd3ee08c677d7178bdc6f184dffc9a1dfbda7ecf8bc66f649a7512081b58e38e17a1899bc8114e8c043992409328713997c56dca5047ad31a7a554dcc33ec830f813723975399a7ec48bac2c9bfb5cb62166159b5974f0a5b2d5db79631472e3db3a1b8ae4e0b7c19914d1912aec9347669c295d74c78e728bc1da8610ce6b29217999db0a7bb0e450f7ad79652a82b653a2d65cca55c008cb9d531e4c49a0fef607bc48d1589a4330ec2cc68b5e771e644a5fcc75893add1978c0757d1dc0f3018b17571ed45eef754507e71114fd55c19e61d9c33a8173bc2f403a0673a90bb5069df9761f47bbbecd66e46bea62ce06793db435da47e059c27ac0eb80f69a1beded14f68820e8a26b1bf4c714e829ee0bd9ea9557e0d916be673169dec2ef78fcce9f927163f15af67b00d8f1e1376012fb84069b1c72d48b9be80c12250e2912ebe62eef028654540e5bf31c8929bbb1dc168bfbcbc69ab51b0bd5aef9112691d78122535f8a4e9ba5ced82c8d2d261277661563b35a9b5cc8d989c1050596364b1a8785167b445e054bc4d3b49288527df718a3d959f4bb67cd1b6297dff576febccbb50d257314f2e524b0a272b79d9fb1285f28093b4cb2c8e11bb364edc86ca844b36fdd7a4e2eafe7b6bffe6b733bf6b3077d08d6146cdf51b8350e6bab6f7fc4101b2b9da02410dcdc65308733d4ee5b2c1a315640b7770564d1148b2ebf71b498763c1b471feedbd47da6db396b38c68990ccf1a590f51479e2e6da65c5199c59184cc85c4e069b3e3b4e36f1e5e868d9366bf2730393a0d539c1d52d9290cc385192b6363b8f6dc9303d0e7fb71cada485c6fb36a52e838f94997ad4f9218688686e57ee5050595da8ec1ae8bac3102f7c81e2e8f71b8ba78f3aa4d6ef05e0a5e29b7a15f8ef7e5e96fed73ec294731395476296612b4c360e62a835135c8be6547439d78a722aa6fcc6da335871b98de3d61be543db64ea49440fdaa021641e1b8a30b7ed83e0a415b63243c7c59c3121c098130eb648123d57592cca27479e1f49ebd6664e6abfcd4c124f23ac5017cbd196b49918da7463b66ae3f1da21c80f9715af15bb3ccbad14b761fb03795a645035a2e75be6a41ef9967b3b30a5b30174181ee4d0e01fefb0bfa3b1b4e9e41f37fa0f069d201d9ebfdcf1ef8e67ad9d3e292b80f7cc097f77bfecfa8cd9a3b735b22bbfbcf577f6631612c2ca808b3ad9daedede636e6a0fb0ef784efafe532bf763afaebf63c5edcee757aa2c99630966f8974c231f8483a7aed65503a63c08e845eebf8fdf5f8a7e57d1c3a341f6970f8bb1ae4dd407b6a8b141251b05d89b31a25e96423ba0c16f70c72e418892a831539df2888c18a16530b6734d97f447b93c0ac2e88c5a6654f2706977196122dac5c2654421c562289c8324f9d72b562f635594c377a22bbd6d6b0494a782b331a9a520571e17ca7640269e78bd09c4e0843969e523a26171297610f3aaf919da53e8736ded21fd15e2eb7d47299b503f2511b30f48d1e28d8875a9b19ee5dee68af0c06bde0f65e87d3e21d54b761666dc77b5ef390fefacfe3b4befd5e960a502965dd61c9119fe1bcfbaf4d5bc4492d4ee61912edc946a1d1c6a129b5f62c4eec8bcb1909e620a680a4c8713ff45dee7299b594b2a1295eaef888f3f92c0aeccb9924516cc9d4752461a9b7c62a702e1323c56c286389301247d3b221ca97c6290867c9991d9003efdad1c2924bcbb2a7e740d19e42de9dd0b8e29d1c3bbb9c3a444a1ba3d7155720e37c473e51a8a2284fda4e1f7cbd0fda514ffcc9f94c7c8eada03accb017ee7f57a881751df7833b416d98208ec63809009b081b0bb55494139a6b111601fb4a0c156d88df4871aeb69b8f7d184b77abd63ac11296346967572256a223960cde5cca5290b39c97037d579ee7848e9fcab19f101539533ecc1946e723d1568c1849916128e4103202b5ecb2f615895d314c13caf3ca96647397478cb3257c2045e78e17ef4b88c5003d9a593b4619f0f373c0655da2862ece4ed89cb4afb14a7ffde7b10a24dc187000414595ac0d9469ebf581c3c39cbf3d9eb5d5f73bd0768600f371728e399b072c83c43defb636872c9aa06456634eafb108349e4e4026d25cfb50f730b6d15724063c56b267c39ec6a62dcb24c99e0de50b8b942fcfb8cd1c9d25a961ab9c9fd0e32ead0a830fd940c10e25dad15b6adf112f2f7587f281152d714116e0db0dec4be3c93ae89cd4c8f1d6cf83c92e919f90f22b18e636879ddcb0e7a33c7cad0fdec9c99fc2292f64efba79e39ad5e90cccddda4c5c0ef1d8b16b2cce13dcaabc9b48024e684e2d9261c79db86d1651c76e30e0170bc71fad8d27653a62886c2fd12494544127fac55a541bb295999099ce8325627ce7d838f934de8819266c844da554091708be931d8d78fa1d2bd3d6cf05c392a385ce688a9e1fcfe1926a463365888304df9e1233cf743d8f74a78f9900be73f6b80c6cde25e584082a5d527bd6d9a3f06bfed35fff25fe337df61cbbea0ef4c965289430cef7eb7198ccd0df10c760a11a71d264236a316af5c6e5504b1d7be272308738c22df8b1531eb546ea3a6aeb3a76e3366feb4cc864605ecf94d7363a5f4a989b0abe647394949523099b605b36de3618f8078c99ccbaf54f66afe9afbffe2bf4d77ffbfd3c002f585ce55c4c1d95416d98e176d6a05c6ddd04f497b446569622713fc189cba276d6420ccfc64117ac681fea332b26aa370a458168f32127109bd7eb504eeb508a6447d933613ef5ecd438f94cd638d9fce22b5f5a439e97a665081be7c2d366fa3dd8ee9f913dad36967e262df55c32972c96c47e5e7e0d175f84cd36e070513eafb8fae20c720ee20a42279c0d18f6f55cde61db9f99cbbefd00f8ec8097c1a6e35e636130c41b82dddf22294da416fc33a855796aa5b56b19c9c6c23956ec1ab761842dc4a156bfd08f7c938e2662861c83dc58b97679b2156d6e2f8e17c39932ce965e9c02c78823892f12deb3e882e629a7a7a54d1cbcf1f9b0c639e6027e5e20a57477b96012a992bc9c391b5638a74aa8e819cd7432772c5b484c266c4d89fda12f00f0751ff3d16134782eed7d30d25b98e232f2dc9fdcd35dfbb0873b9d67c10cedf57b76e3680915ed865a12e3b63301b70187389bc76216a32460dc566fb148332a6a19b6dcda4d48b479c7ce814cb6da88b28565c29bb9ded88a60b9e9a1de4986195a7396e421e33ac7e9dd37f73135af71697f7df1977df3d05effcd6ee20a389138c4812fd0e6e1bba838135cce6d508e13c0a7549458dcca316eb30cb734fd98c602de4ff79c2dd274671ba5cf9467cac873839f37063f1743996abe5d1e0279febce6b5b3ce97a99be0dc74f61c32a710ffa0071c35713e3ded6c9607df871d4fbffa126a77b62622e522db3c253e2bcb5070c6dc6a5dbcd502ecbbbcd1f87c36037d0df8df6bacd45fff79ac34f691f67b1678643e151ee52d1270bb9f602b60b0a82558d4817e322c425c8d7b419cdd824d16276a8dad7d837339a6e2be7e87ff5dd64ecd787264ea7c2453e9c0ea8c561966b5c1442bf52c5b055b4af5eefb49b67668191498e96c28e65b76ad3bdbe84f7dffad8f6e0f39763554927fe177b105a4200e59128b73b506d946132347a2ca622b68512e5db015c52871393747f04c831cfd1d3c2e558495279e44b7017364c2cce0cd8c263b67ea78dbcc0b8a681b70fb1afc2e60bf80989970ab1d7b1ef2a65e72f55ffde47e1aabd2b2415e9fd78fbf079fcba09770720af18b5841139c9304e53ae8ea2dc4e86347aa518e5a882bc1c98c73db9475139c632b7d771e824c3fa365b6c40caa75ae122c513eeb7cc0f90ebb08b84b6c8bf273370f5b9cb8800d9d88f19dcbb81fda77f6c32fe80ea47539b9a6267b0815f6d8d1c443dfe37c8c711fb3065b505ddb66a88853a420f0973254b12f6e2b711b4b9a6c14922371c62247bf505102ffe9bbf3a13b97ef814536b62cf3666e402ca61248a186f9706127f3d460b362980ff06156aeddc5bad66fe1a2fefacffb27af7d74b18ca40da04892235543bbc3f74bad9bcc18973362c4d99cdb921430114dd28beba8dcc6daf3aeb5e73616e84d598c1439c2ed8736c3cce0896214014fb6f3d435ab022f67cfb61d717e16d47691d9ce626a919cacf4d4c06b2e1443899ddbe997da652e2c1135d565c8dacf0c3dcca3ef24a191c1914b90e2c59ac7b1954f6b2455ed2e676aafc011616da68bbfd89759a8c845bfc7f62764bdb5c7f6831fede7f618b4dfd39311d12e46627ff54760518ea8d5c57f73d821b96bb90d4adc89eb9098e624da883872db30c56dda6cac2cee30e7bbd89a355ccb6071a2b961312f4db03fc6d30b6de964e310cb7734d17410c8e9c1c784ff361f777fa89dd1ae9bbbbf41b698a3af0009d4d152370938d482fd6f266c1cc423c548dcd64880afd2c4ae91a8371b31ca368a966e2c95795fb6080d4a30455bcdf01d9c58452987bcac225b964d26e52c51b36c6b387414f8030ffe28f053056fadf918abff0bdf3fc639777b68e81355437cfa38070c16d30b20e18de8d6d8019e29e71bd185b8a676e3a0c64d488a4495a3493a019c8c2cf4de1c74fa856f47ab8dfd85df10ede22f49edc95ae3f241e32ca64ba2ec5bafcd1852b813c7c1e71d491993ab0e580e258b106ab29a47b652ed89f2192d832628b466276917cb0a1d9f23841472017e829d72912d12d97e9631f847b606454e5c8ed4e1728cb718e38ec007fa2ae6e195cff3852fe3452ed55864e54d9bcfa33de9cfd9fa473db97ff7ce86feb69efc18f7f013be827b79fb108fd2cfcfa34cba8d178de3ed78d7707d32d2abda1d3c2c44feb512bc3ab43dea1bd036055e76e8fd0ff6ddf5fe34a1351cdacbe1c6dbce199fd7da2bd618e309ee2ac5bf6e7b7a026ce5e759eb291d2685b8a876d4d5d5c57c584fd240ac4227d39bdb3a0590dfb36019bf614117c876cb59b71ec89cf0d767fa931e2ad73152f0a10ffe4ff6073e92c75c927edeee7ddeadebd06ceddcf9e61fd7ab3b1cb89fb31b7f5017f397b90c039deab7f98243a5ef8be83ad3fcaa03de9e81f84736e086583273c2decd5947b36b271bef71eb449a8cdf16829d73892a7f380475c043d77d14e672b33387b9eee3f12107128ae65c2bfaff8dbaea4b7a3caf5f5f1b70f78837c13610a6489cd534a729b22401b5518a149abac98c45ad5de3dce5a8a3456e6e0b34b127987b0f7777f822d2499890d6e6c36de45885663885ac384aa991e57c8b78ac112257a6142e112f5b6e9255c4910f1b9b2d2c1b31564eb69e247cf525e1bb23111a5858f5326d1ea635e76e3512e6996730e12a60b40217877a57e0adfe83189fc166d2fbebe28930aed53df61fd6fade5f04fb320a14bbf2964675dd3f23f68598436e02ba0c1c247ce8705f316900b7dee23dfa78c0bbf6db3b9e329e94935dfd8577fed2d0116aef817776b68edce735dedd423e9331fbd6efd537e23c6623edfd2446a7e7a008a3000a982dbebcf8cdd40ff60f2b4a5082c11e79415600fe28de4da20489904709b64b92b89c2e2047ad69ee5e36a0d7bc137b0af60fcb4e85601b2988946e68b30b479e9f77057e72d2905ff3d177934880ab7290f99e34eb79eaeb7d74c76b7f6e1f75edf7df0eef1f06df4533e089968a51023c7163cdd38d157018e2ff73690218cb4dc08f6a336ea2b6ae25d5601fc18ec4bf8ba9c89ceeb67a635b9aeb64e109e7c1056c8f168b058b8b1684d122b7054c151e7d4ead42e54b057a05e478e9f26488037a6b0e46bef8537350f8ca345e6f8df37a0bf60d3209baf9783d86c1963b60adce26d46caca0de8861b211ed8b9b6411b682899beb0df8d2a8680bae953634b1059adb2cd8c73ec45a4ae5223b5a39cb50b01c5c074570c624234e8e9aa00da5a098cf3d3b5aa0443a93b892d15653c95673ac7cfaecf1748173ed626d4b1bc93427897bde88d4b372390e45f9195906b5982c2262783296f4ab23620dece29d0ec86b91cb913c74ea8a16038e7d73bfe92714ff8a8d286c5c0767b420c7b5437b2cffd07787679bf535bf5f059f4b0c3a30e45fe104411ceac46d75d06d62b74d390a7efc244c5c6bc6bb962ad0fcc3b8ccad9756ba95960a6eb33494ca39c985923897a5c186aa63874f282fb73b59e7481e6dd6ad51215b483d4ef3502e7c0d18a2ac38ac219ba494978f785b8a761eb56b96b636c9ccb0d05c9737261e6f388e5c128fcb2683eff231868301bf4cf08e5c0c7e653fbf8815013a061d7d56f5872c6b2dd8e37073dddf4ca713255902367494208126e88244c8032731029ab55401e51af88a13b7ddb36e1be51fd1704888a1177a6b2ea689cd640ecdf101e7e9c5d812dd2ad2b3b3c5a6cd4c899e4536c9a9b7e23313b3e577ba3db481532e76092d376655e265f9ddcae573e0e0b391510d2b17c34ce004ab8043acc1191c356dc610edfc47fa025cd7dfc0abd9094e22f2b6467bb523373f8badd4fff96ac29a428ce4ec8b3ac44903c6f2b92c051fb1e7d0f2aa6ff47ac0aae34f0b7638ec1a77b628dcbcb5efba35fb055bd40b1a6898f363dfa8022c7cb345d1042792402d23df38884322aa370ae894f66563e9c24601bf148d5da827e0c80975b4187d9c0be311d97091c46e83021b282be79e245d5c8e7cd7b7a1642db3c428708a9994f733caf8796523667a448a7cf1392332d9e01949d87652c3405bb20813c27bcb39a37342b251ec89a910d34ecb895da0dae3d86acdca79f883189aeefa8875460c0171af8e70a05bb0dba331d6f19a7b1170848113e142657a2d86fa52bf1c4f0cec63c32653551cf08e58efafb4b734ce9e033aa651d23cebf4823becf310cfd5c5362c260d7eedfb7888f3fa199b82e780aeeb56be9231b4b3cd6510d3c0ad9d4b192a7605df0eb92703af689008f936468c1292bbb991baad7aa1a296634b65c056e95a3a8f1519620178d4220189d187bef230c1d851044f7730e71719efb106314879f6ed6ceef2c6915aa1e9c8119cd0f7d54ed025e4c32366e971e74c5dab350ccfcaa2a0a01675848d4dc213b564db6535de93304276c45b52da20479303c7a8bd647f0ed21fd417e8e2184873afaf7d4d98fd9083b3ffba98c11e5c5de3a625d8f3109389deb377fdc29ecd32ea80cf4907dde61ad335dabcd09013451da8ebb167c176bcb148e45ad2043934dd88618ada10b2b413d46ab19be3182741bd51d4777169e707b50c8e26a186d20b25f15475499805193e2336aa7c897d0e449239c9bef5cce359678c6348a272cd644bb24467ab955bea940b2b8fec501126bb9c359d9c72164b2e5e121e9dbcbc984a959125ad298b1543b96c9dcc287a1fd5f8bd461fcb6abee3a38a2797bf654ee3ab0d6d421d95073ceb5a6edbd50e029b9945226c85311267176ced392a4a3ce6f48b9bcc53d4dacd47744e152c044e2485d9fea2b73ae7cb91e7b2f4a2f3f3f58aa18c453403f2632c7bc2ad1923c74ed9a2a23c8784b4811df0a6455ba388ca50cac4551bae1dfbd86cec2a75a429321583dd6c4b849758f1f3a9633bc2c5b53b3a5fdd78199e0db5035ed3697cb3fffc1c0fb9f2cb8e4efd3cca001304cde04b1d73f7a02241ab251b1167883320ee2cc2d60cb07183459703de82c57d4d13bd41e2bec109141b7e577fa2bb226c6953e92e912fc44682cd6a95ee64966e65a2ce604a451df4a7db789859379e37f8687ffde77dc8b73ebaf8c61bae1862d947fe59d35c6adcd66568e7e74a2f98432d16c1cf05763e9dede21438b0df231e8b12e72661f2115d79cd943338d9c58b29f515a9257c295836e6882c7f75e42c33ed880bece93a54848b9e1bec2e33d46059d636299ff42d7e3619f6e024da7ac590939e679665654f068bcfa64d336b3b774d1eea1e18949252b3b77216b2035d6de711558c669d972df85337eff85337bfe44fcd6ad03b8358187230ee7f83cf23bdfa3c5cd8a32dd42bc2e0f7e862e836a2ca22311068eeb6aea5451bc5e65c4eeb7305443946cddbb4d5f3c0f9da34bf70480c273e1f594499aa9809bf5b89acd00cc79632256851c5a16c942e57b636c15bdbb91cd65ce4ed9c6ab391aadab7d91cf38486ec7c8b58f519721371a69ec3569a38f9c5b59968e190a87408d1ad168f3cb0ed73f9b231b7a57e93078e76e49f9bd7a18fce4f7f8d071efcf4d7382537495bece8cc46b42728c7b16b49dc4641354e20ff22653127b158915a6acd7864a90c4ddecdddbcacb7366be58665e4611b3a5164d9dad94a4bd004d7b61226366b4c8ceefbcb0a7c85eed63800eee9728edec43dd2d58ef9533ceb96db0473f0dd77e0846eb0113363db83cd506a69a236289704d791b38d03f12034c78ede600ef11bcb659135cf50b2af3796c463e05defd697932abf996ade568e8262f64c38f58214618249691a4a5698a9dbd84e9474fb6bccbb93e63bd480ac7f6b7fa1de1efcf37ceb7bd0b082ebf4bee95bae1fd40d9398bb39e05d0b31c80a6a9aa89c6ba91737572f3851199418e9469153d7c13116a50be2246ee3d8cce6dd3c51d0c1993396b2d87488ea32ba405256350a59b2e369615ba14ce532b594acb278ba45a991ba7ca478b921ecc841b00a1cdb4d95b8bce606846e8da511b9f1f468e735ab3b1712386cad17fb89e144ba4b4273c54c19c7927e5837ec2e8779af6680db66773ea5abbf1aee3163bcd1226733aa64d190abdd74f4dabcb55f67b75c8b9fdaaf8ffdc05af90e493d47aa3abb3fd8b9177d9cf950c78047d63cc59c9c773ef244e56812e534a7117270d6e1970478a5dde00435d88218aef7e308bc6524394b8d0f1c7615e687f38a8b9e28236f3d9910df668f2499777104839fa0a20a695d5e2b83251461eefcc1f53bfee05f8a2978bbbfae8e09d83aa0be06c42025419ed5a1326d7a9931bbc98c6406ba6b842d9ba38a3e811c3e6cb91364d9357590404539de58764b139c6fac79e2e62a87ccb7650632d956dfca179205adb33564924f15db0a5748d18e44dc9f3d8665516a031eb91eaef337fa46ae7d0cb4321cc2f018cf872dbbd93812434597c789cd801f983ae02b07ba70c1ce3b412d8991e24eb0254734c7d90778ccb139f6193982476cbbc61c9ed86c59917cba71539d218e74b6da0e8ff5f50d1c2306dc8045fd2dfe0ef92abf1487dbd7cd625337bed5cea28a0a360e06df645cee5ad2c56d49e626f3c8e5a40b6a11e3020f6f71e226468a2d2d47d63cc18ec4a1246868927e88f3d78cf095402c6e817588f734c5908612d5298f1dc2d32cb4eb672ce3ef5e964d5c225d764b0323bbc2467ee04dc598f89276dc2944b0d2cb93b7cc56a4d8f34162344e1aae747238e3fcf26c32b80c659cac19a10a8658dce17b3b9b44c0ccaf35235ef32215f4aae617ea743cd4a7e8e9ebaeef0ef3034d07032fd279b017d1249d504be5370ee850e882ac2cc756d0a0dcae6902b981408b5ae6b6b37ae37c68475a994e74f1531c054c2aec6cfdd9e671e5f1326b4ada84c858869a8436d9b37a410c3f430dc9111f2c896113e979b3cc4a5b84205cc2122eaae9d2651cbb6648cab06e0bb570b3d221a14e99c3d94f4bc35acef81fd99186ef4fdd9ea731838f7fd55defe2efdd7768dcfd15d9ddafc375fe99a1bd2127b98558dd2cc30ee2b068b30870af15413c15d43998b88ecb600becee344722d4e190e3f7785b8787b7fb9ae49349c0cfb5151fb266227fc519e22927c42e918b8d3c9f78a2b1b1d228c64c6599b65019245c7b642e63595b5bfcfc18e6d9773fae361ec752df614dcaceb88dc226b89d7f472989293114bbc04dc08791ed90010f3fd483bbbc8b87ad5f8a017eaf0edce5868721164fe2711b418d5488af815c64d0fbeb0dc4a98a506bcf88a825e7ae23c718f011a7bfe707eb78850e353a19cd312da3f5442afb8be38532021fa63aebd859e2b5e1c966a41617616688e1244cb389d11aa59fec6b2f9e129f394e749b2c5d129efce230091379b953a627cbc64788edb00ac43a6915436d938da35e1cf907b62fe5212f7bf0f1dec76718995b90a2d797210e5f7d695fbf8bc5fe53311b673f660f140e15da6a630c423dd4b2a9467dfd8dbd73d7cfcfad77af4fb2a37e3eae318b9319c4c94554a10912e731edf2b3d21a6a4763c71536a27b81f865aad835f8f6308726efacf1654d68e16d49438b701ee62c1f4872ba716865302ee3e54422793da19daf936d75517d272659fdc59864b6e7d15b04b523ef629051ed5af6042746e6263873210ed9824a795ae6e63647c5a0e9f0a348232466e946a419fa383f9ba1fcec992a35eb32e1334a223694b3e750d2da20a517dd0a55930b1b4f09f5800b38279eca348fb6a66514be28ab212f4df478baf4497a46cad475127a3292e860c8340b5874368af9c9716469cd555f0917517b4b852e47765f9ea8139e43258b4265da61701cbfe53f99413cc42fc4090819e5a68ce7c88ccf77f9960ffd767ee178f4a9e19c824f240921829b4509892007855a29d7d715a3114ef4c94684aad95087193138a71fe794c9a5ebb6e11ae5f6b3ad849969c9992169d9ba956a7daba55821cf6e4e9ba090971b89d4ab365af98ecb98692621a8fbd0869b30c5bac5969b1557e54662f37839576c3be5fd2da9502a93c0364aaa4cd781836d8b1f70c543dc07c461a8efe83877f9e43f35bf8fbca2a3d9c798936ae01d03fd42ad1ca945adcb74b61c71dfba9cce630b41fe8000b19e384979e4c811ca750189ae80c5f7f57293cce55d566a061f161b51ba5816ae6c6ebaf597d1c5b334c32524f99877de62ffaff8dd847c78f82e88e936b2d1bff75ebd12b4f815fabcefa7d3811e78eb60db197dbe3c6eb5182972867339722dbbc10e6a37569661712fb88e91438e30521083440cfc2141c9c73edf402ee7865929667a39efecccde6d8d34b08f35b148bb62a81628e50649a58e98e99391a7e7d08accdda29249629f4953ad881c2e1d2998ec24fa44b754c68cfc5dcfa914d811b76e23ce12a38bbdc59a2745a78068cf831f6735c4ddb1413ec4f137efc4f1ff8aef74a87506b14d4097b73ebbf8fdf616bf9fa5ae053ed330a68e3da1a2d450c84983bc6a8ea4d851799ca42c6e0301715a827278e6c3f832d1db6699c5470a594c574e916db0c3d4bb24d36916f158c6a29e1e389bcf8a5d224b8152d7212f7ff7954832ec70a967b38bc1092d2154b6c4798d137cf099e04273fc1d7154dd3982eb59d9c6b1bf307e167eb516d5a5ab21b52fbb43751ee261baf823e9adba7ffdf59fd7275ef705b137bd6dee55ec4de7a3ee6818a734716b2c4afc469cd5d871599480ae06ba9cce6cac79865b95c11cd42e85dac72e4f93e83d5bfa656da1464fb54cb7b59c38e984246e6db312bb232a6734d30bf0d2106475316f7c3e12d6b0af96da5823877d4d739ddd9ffb059abbeba7933775904f273b93ededee3d6ee1aef246410d6a1187ad190b395554c439ca5df035b41b85a4a0bb76f9e789c4b8b916a35cfb703f53657fa685c6e98ce6f804b7869c3d852d3d6d2c95f30a57c0ad5150c538780cd5699e6d364aa45b5cda22398c76a2cadaa4149c942cc39c1c431b17be922dfc42ae429b79267659e92969dc5426469a9d0d45fb3ec89b0ff8eb7d5c1ef8ecefe2fec0677fe78fe8f96fdaf3df62cefa45d6c53e82bd01bd135b71ad3bf5736b75eda3e30f4b72da3918ea140ffc61883f6d530e5b38ea626b7295775b39a316d81af486266a8b12881f2419e468a0366d708e988df21e7f902a5fb97ca58cc08562a48644fe6e6fa9b62311c6bccb797955eda4b4cbfb0ab67886e309ff665e4f7ffda7ed2c8f758383abfe894539755b3942a2c452abab977aa1904799800e45636aa18bebd018257b1e836d3d91e3f7f2bb3bfd9350914a9a69a5d4764439dfd9366fe52a6764d99910edbc66b14e2db7b16d1ce9dbecc92c28f18ad074edac759b0aea5e2d0c47284de5c2ed245af964cfe2b8529d627e30ed9021c9dca5362e83546302c968c872a81b5c3cd639037c097ed4d734d45fff791a7aeca7a3a32dc982221b0ffb041b6f73b5f1726a83c47482c5b441228edd4465a9e24e50324f5d884bc86d1e8b410d3554a0be35eae67ef62e2d055c58194e55586db8a28b4a34143925ad5dbb8cbc3595c832f26cded1d28b78f7de86a9be159309f6a74177fc29b9f05fd44d1e751a9cd004f14854db2e37ce81bc3995a1a21c618bc41b519f20719ea0dced6ae7e0645fa38ff3c75438ec7cb7a8ce41219f774e78f29d4871d32a3225c84f53059c1e9e4dd998db243aef24a2e03cdc84dbb969716c6530a5e9f3d9134d83d6e5f03cccc94567b426e4d5332ab2896d9105c9f5b3c11a47ecc885c587d6a0d334ee36a8ba3c151b74e0376da4fdf59fd7816f7d40dc0faf4561c3f635bd2cf5be5e41e73300fd64e320c1cd518b2d9a0066a4d65e4089cd419ddd8d3563370ee01cbd462dfad0468aa5e9d3463288b90dd3309f46782b633327c79d92d5bb3c3d7b76a979965e5b2d490cd6bd407d98402487302bf58d5c6e90ac3f7b79b6f198bab61d523b4bf46c6cb1b121d16aa364b5611b67cfdef3689959c42e75c4fed0eed1d5237aac7104f68bc798b9c7d84cb8ff102b35e6f734787c7f494e8192d5b79c9e2eb6eb311f249db1eff800d85ff401bcc83b81357eacd1b206dfea2dce5bd85872e6b650db5d15706ec3de805af93cd839dcdcc8a9a8c5b8d559b7452c4df61394441fd7a8e1f486907261a6c6b3a35c2866c2d82ca23c60ecc99a133893ab8841ec8bd1a289dbcaad674f389bcc38d7ca742266d8b7a3c5460a4fc1122736c76233c367238990c5644d28462c5d4c791be2e88aacc552c0adb80e43acd4e2450c573a83d8cc8e4fa0186233d11bf5ded51b9ef829fefd326eeccb1bd798e6c1ff221ae07fe0205f00416e2be806620675481937570564ed6b94bb829beb130471b79cce7d583792649edfe2f546d42c430c4d6f9b9548b22f0691759f2bd12e099f0385d47e860dd3cab41d99c7a61415084a652b8483bc40334df9703917ac449b1bcb68e9b2726c4a011fe658b0d3e81929380e7939b1b75a49b643dc5681a39d34fbbbe440eb6ebb1a91f0ef0b7e1fc610d3812df702b5f151a24ea8b5bfb896cb810d0fb7faa48b2712f7cd46c129caf516bf7bfe8954514b677d39f3743e5b5b5c183985c4f919962923143e5f5a48c467a0af809327ba389902be7f0b53f563fcf9984c68bf93fff02fe8edf1b57642b3811aa39dbf04ceb18862aa1809566cc85d62682ec7d8c1916bed050cf6ca56aa91a20b1fed51ab901d63e90a64399f5b19f96ee44c6d25116b5964b993ea1667a430b954587332e314c1f34e0ca9910bc646c1cf21439726af1df13272f132db864b2ddb59989839c3fa5dbd6212fb595658cab40e48b8b159ed407a9cbf0a3872a2bd4ec9bfe91b1e725a7f613f667edec5f875f975609febf27247df6f2ba71b45ad31f8a1e03c34c5e521068d2aa03ba2899b1b09168d0cc1595a0acc354d3e3c47c9d65a9256ad6787896db34ac8ea13389b2550a631b12b214cc23248f1a6db4344af8dd69da0257dde889140138af454130c0e7b663ab5cd1437449e353b996e7091692b36fa6e4b6562154446accb50384f8b918b1fd48ef96ff346aff53adec861fddb724a617f785027783161efc6f2228761c819bbab0ddcf9d67ab9caddf913c67a39d73cf33f55fbf205a67d2b87f39dfc35a0e38773a23a1dd37a3347e9329c53f30b7ce1b1af75f3c6356be87fa073f0e353cb6d31d0b3e2d6c89ae79823394a5201b7f3682346114e0206b56abd11d3899b68f97b357b7a3a2f7150501232368b1552e96cb6f565435b71d13248430edbe9844a84f71d7c5eb7b3dad8d2e75d9a6901abf2d6566e4c657a72535d08a42f8d654f7323176a2317185f0c33979f7b41736c7d123c3b0ae452da826de31fd1f9afd2e6c779c3c0a7160ffc68f53a0780fe6d350fdec837e879dad6007b10e3f35d4cd099c6436cc7b8ee9c2d60474edc4465a0d025cafbf31ca9358fdc9ce4d8d12264218877a8a9087e4b77f2613ca45d725492b734d75c6a674b971c0497c95020a289a9088c9361c5e6beb43e090b5c444b97295701a73f43db76ca7e0d1c99759de0a2b76e4ba5323796016f713276b6a1eca611b6f8487612f918106a3a64d6588ccc0fd8e2ee7c851fdacf7fc1c67bdf4f67d3e863be0a23871aab83fd7ca8e7886af85ab085d1c4e550b217b08558a8474d15bd4696dd808d07b5388673635092f2d4d2deb73ddada5797e01865d9d9970dc796e7a94ea8d6d9dc6c9a040a2d3bdb63acddf810337b956fb4bee7531003d2e77abf4593bf143b7997dfd2cdd3b5cfb12efa188360b917a833e2427e420b79a319d47c9e204bceb188783893cbb55201c1f90a6230c1893a79cf06844c56f1967b2148b51465618eb24ca699d4ba1cbd38e974612706f5f900e28b5ee45b6943fcd99bf350ffca3c84cab4041f7aa864b9e7f47179afae3dc4d4229e5a32d8be18dcce7304b91d6dca6c14a88305679f1811e2d08442dc511bb028d72036adfd08bfed1cf9abb998ea5e33750c275a1036b47d25d256bcecee244371944be6a506e0f83290d8e36e1ba5ae05741eb1be224f2cb924568e4960d389cf55355ad2c471042e54c8ca5726ac910a86216bcd4e39b48e836352f4f8ed5affb9a733aeab5d70574fa0b70bb92764eddf9af75b9d819fdaabc0f3eccaeb6d8fd73e618f421deacd6883546c165b10073303db58bdb1740e7346e2e610e7e6f2a8cd52b061d044ebec466e2bb5c87c9bfe3a1e488c673bad9acd1613b28c64538c305528abb358c72c2d776929ec326d6339e577941e1aaa7c69cc426d4d3eabb0954ecc8ca68653b5e1b6d46d1b9f7c89eaa4359e775666acb86cbee2d868636b4bdf9a718e2c2f7656f423d95751c728dd31f60ae676f1105ff1d15942fd1a8ef1915257fb09eebd5aafe1facfef93fb7e165f86f51b6336f56a687fd0e9dc1627590631d7708634e44c534baa513b635c47ceb023b12ee87509d4f901bd675fd3776b82753e34c693c2a3cf86adc1ec5b9b99429c8ce5b3296f3bd3ef2697d6a1829e37123d591c5bba5b4d361836a68be9d1ddaabc6d4f652ae243985f2688a135dea69c915f246287c78d5d3f078acef88996adb8299c61877d2732071f1aeb17f33250c8c87f8e577bd0b5feeddfc093865a0aebe6eeef1771fde0fbc10ac92097b3cbd5b3dc0b4e480ce753e0760f75821ae4a80d755ca63b1b4821efcbae2d6e36f617ce48e93ac859c55f1a9a91186b2a65b1b5a496936a5f3bd95590c6eff5bdb77390bab3237fc5474b1abfa32df89719fb19686ad6a0aea65a96e036005f21e404c6b0e7a9386b5d8ec4d8daf31d0e16551eb746329e65ff969d4077f6ac950baacbb8136f699fad249b435c90276aa96e1f5a2285c687f6c6e5cd5f349e9b86e21f9fabb22808e3399776edf4f5743a5d63f156ccd05d9d969f9bcb877e3a5a7aac0533d4ad1bed3059beb16cbed32dac79841217623e7937d1001d5d5002351ee50459b3662342de043c8bde9d5f3f21ce4ed22f3a977998a8bc25b1bccdaab52969739f954f781be14e066d494925a8578fdeb29fb6b779fd29bb131b3a595717b4eb67c1f4fd0cb63be400ae86fd61b370ce36d4e2765bbb460ef861f73cca217fdabe00b6411067f523d9b2cd5c3367332b9779c418273fade61653ad0ccbbe044c7d767299d22c5cd9797d09a4a34097ea65433245e7eddae2340b25e8a2dbea65cd5ce280186b3b950dec54fc469204b4d4e638a70d5e6aa5c5929ac8256793b2ab3dbb28681414434d50f31d5cfd4b765168bfcb33829a36c290bb3ada9c1bd04369326b5ca89beae82d5274c6b56c01277076b52ad02460b0a532ae254d68e2428ddff779919dd57e1122a3c58c650b96af5c0e0173392156b36c2efa8e5241ef79d1ddb955cc7c37d4d7addc9c302ec47cbe176ff8f3364cdedd1a89b78418f3e379fdf87b38d379dc4b5a8e12d0d7100bf55137a21641ac08556c1ee77202675a204b02fc98204badb108f938efe56e74f28fb3da686e32a18b12cdb3f32f9cad645f8d9411a884ea20b727a683d79e18ade9621a99a2f644b732715bcae3c5f4bb9e8647b24c1b5b0c7322218e3a958d24e13bc9c9d2cb43827263a9b7a4b10a0c75df324b22c68fceaea0dce5ecc62f6a7165436d976b4ce9639d1038ab62c009f1625f4e7ca76ebc020f75bc25e135dd76be98cbe6e7d76ce8a3c398e3df433ebc240cf4cb6d44880f9dc39ab5289909d44a19d7d172a4e81737c7397248ee7667e7c909c856ccbd5b1ba7c3f3748b3d3f2dc10e589a992a846cb8b6b6191b665846803d96126b49983573f91438d124e02e2acab58965d13474e483951d9e4d89ad75e672d03379e9e455ec887462e7ee6427ca14e580eb430615521b385f980df9816f6c38c7eb6bc2d4b77875905351e52b1aeb3997b4ab6951c0f92bb321e6e2fe1ec4c8a4efc8a9ee3afff3bce5610cc33a5de2d0c98e70f6c6baabe937c60b81ad4383b38b5ba84fedb6368b139240ee13b52096d04850a2a57086b70b396c228d2187ea7d5e4304ccb1badd860ac92f1392d3d210e7bc67213648abc8e0b1814466f5e9b74fa5f7bc2baa4f7ffcff3e7d4df79ffef8f4e9b74fd8cb77f0d7fffeef6f9ff671159dfc7f0787fc731b44de71f7f958edcef1ee8fcf65baffec956516075e151f8acfdfe26cf79f6a97979957ed8ed0605c7c3bc0bfe1aef2e2acbb54f44dbf78f6b74fc7b8dd7dfa63c24c9f7efb941fc2dda73f3876f2fbe4cb849db0dd95ff5471f726c7704fff62997fb1bf5bccd31f1cff0737f9f784e79ff8e9d3ef02fdf4dba7f8f89f307efef447f57cdafdf6e9d874dd8abbf3a73f9e04869bfcf6492d0e9ffe60275f5896fd32fded13cee222fdf487f0db27d4f5cb3e7df9fdf7df3ed971f8e90fe6b74fcaf0eff63fff29bd90e9fe3642688ef9ed937937ea7996de7fc43c3b04e9f1d31f5f7efb34abe21c0661ee824f7fb0bf4f398efdc231c26f9ff011ae3c71c2133ffd7df2f4bfbf7d426f3e3a191fbd7ee8fffef669f1e71fddfee73fa7e274dc859ffef83fcc6fcc6fccfffdbfb0b4d1ee198625c26c7dfa6c1f77cfc7cfad17a4fd32ef0f9f8fcfc1e7f7d6ffd36f9fd4bc3c3c575fbd2afaf4c7bb747223a7dc8b8b4fbf7d120f01d0d66f9f2cef79bfabdee9da8f8b5b03c6e150fddc10915705d1a73ffecfa77f7ffabf7ffb64565eb61b29a3fb61ecbce3a1f8f4c7a723fcfabfc25db92bc25d11347ffc5fefb5dad1fda9027afeed937290e30c88fdff749ff7effd01fae927a6bb7ad74aee3da73e903bb4b07bfef4fedefa1ce421b423eecaae11ef3988e2f3ee73e5c15be3af362e3ffdf6c93f7d8b0ff06f039beeb74f410e5783435e3eef8ec7cf7e1b97dcfd856fb03bef2fecfb76aebfdb2cf6bbfb45e5c5c5eef973161fabe1c2eed2fdf5dc94d5e1fac767afefb8bbfa39884ba0abebeff0fe6678f46e3f7641183dfc7ab8197282c04eef2e64595c567170bbf22d2e8fec84b95d88d2f0dbddafdcbb7b382ad3dded575c54bbe7c2cb3efb87e7b8d8bf7be3b3efc71fdc3dbe79333814c7ca2baa8e61bdbebd2baae743d97c3eb3ff66fecdbcf1c0abef7a79e771c2dfbafb791fe41f3d91c5de472df8f13e3f841f3c1044bb20fde07ef8ecef3fb8fdb8f26fdd3e7a1fdd7f491b6f3c517bcfe1f1bf79ecf3b778977df4cd8fd4f5faf603b9bdba9d671f7f539ea5bb8f96ac888fd5eea30efa073e7f8bbdea83a79e3f1cc431f238e1e9e307f88f6f0b2cf7d10327bfca761f3c5065c70f1b80fb1f8c20f082e883e6c35d79fcec37d5eef01cee9e7ff05c509e7ef0c4fe10eefcd30784de3df50e1b181e89bce3075be15064cd1b77e3bcccdeb8fcec156f11305c0679f5c6ad63737c7c290f85bb1f8f34fb82441f5f7c0e26773fee5f3b461efbf0eb81c41e29ea2501bda4972abb635b55767c35610f0f5c04e66ef7c3afcf651a5f3efdf629f42acfefe4edf7ec73f81c9f77cf2faf8e2d7ffaedd3ae080e612f26c63f3f7bc782bdff0dadf1dccb2b4f93872b71e13d37f75782e3f9fee7fee0dfff8c7697fb9f09209517bfdf1ae6e38deed7b7ccdb1f3f7ee450563f78a28e9f77af9e80d60758f078e3fc3019e52ebfff79c9bb013f3f1f9ea153181efc93578fa0c8ab8f9fbdfaf8afcccbfdd0fbd7fef07977de15d5f1474ff57f7dce77c7a3b7dfbd78dccf3c10ddbbfcfc1283f9a7fdeef9f3fe50ed9ef3c73b41b4dbef3dcffb5cfa2f6fb4cdeef9f3f3ce0bb3b8d83dde0c9368f7ecc73d0b78318a5d1e3f97de310e3eef0fe1f1f3156d7dfc1840b13ff1c467eff9d96b06dcf6feb3d5f3ee07c3ea9e18e836da79e5870f8f98f8ee896f5e15479f032fdf658177dcbd79f3901d5eacc3fee09fbe7df3b2c3e768f7fce2a5fde1db3380d84390be75e3748ac397d7f787cfe5f3a182463fc7878fee768fbd7ca023977d76d8bf79e34fbcfbf848f0affdae00220d77c7e0392eabc3f30fdeaa9a7277fc33cf7cf68ae64f3d179e9e3b95fd4f3dbccbcbeacf357bac9e4f41f5a71eed7644e5e52f286aff5c06ffda05876373ac76c3cf430988d90b806fec0f9f0f155c7d7c0d84681c1c9ecbcfbbe7e7fad92bdfbbbd3ffc2b3f6555dc719ec78712afd8056d7afabc3ffceb58c645f1923b24feaed855707bd0845e1153724a4e9fbde218bf66200fb73e579e5f3fc39f8f0fa5bb735cf8a7e774f7f9788cfe131c8a6ff10baacb4e457cf10f4174fc7cae826ce7158ff773af88bf1db230e8683e2fab53fcc3074605ef074f1d83e7ddaef04fdf3efdf6a7b5da1737dfd8cf2f9fb84a9cdc2b8f1f3f5aa6fb5e28fff099cfc72a3cbc6aadaa8a7e35b3c3b3e767bb77eec747afaa9a776e3e9f8a5d1d8755f4e27e5c05d12ecb22e8203ae43bb0483d3c714fd53ef050f8b17bfed7fef0679ffb3cc8e3771ebefbfbc3461f9ffbdc8bf23ff7ec2b9e089691ab50bfbf7eca4e793cfcf32f90d7c730fd7ce6c180e3d5ffddc39fbd32de7bd5aef69affeebdd8cbffbb177a14f1c13bfdf31c7c4570c8f343016303fef65fbd339a0bfeab97c2d8dbffd72ff4b2f6f85fbd3742b6b75efaffb3776e4d8afada02ff2ef3baf70c08d2dd4ed57e681845d4765a6d0179992210813610361715abce773f152e8a8ab7397bfeff7daaf230ad246b6158b9ac6485fce6a25204639c4616fc2d25a669dcbb573744a9e3050fe926780583c78c42663a4c19867b5cd1f2cad5d8a37abefd9b8a38efb58fb5b05c7319ffde2f3a5ef27b8a6e9284bfa789b073ee0eee51f4cd3084d1e37a118c53f41b368d601265bfa1165abf679852918942ab61da73e30e6b18c56713c51b3a1b1cade2d0bcb7fb17e98fc83e34426c19334df023b20c0e131bc64984b347d5c28884ef378faa457019c1d87d542d0defd008b00d3f632608fd3b84f3693ae3e07b44b3c43d6d18b1095c2f32c952e2abe3259e13e0d3294a0c23c723f9b6b75c32e48f4fb66842f2e744325cb678c6324fe7a6653a06d1896f2e32c2329850cf88acaf36e358cb8be94c6c99e733fe5a7eee242ee79eafd0484c2471a199e41105b2b0fa8a48f0227613e8054cedfb89de06c2954fa60464e7258d4fcb940218319f267460f4d5421e0c92b3d95da3c861620d4cc7311df8904e04c986168ce2c44ce28734e3d00ccee68937741237c24982ee7a74e6ce9b276ee42d93fb25c92a9d311d183caa53e43da8149b7e88cebcd74db59d17aebcc03aeb641714233388493d967af7e834c474ea82c8038c0f93c8b34e84b666b0cbc82af66b830dcff73aaf649fbc657053344df0af2697722edae445cea510b64cf42b86d1dab3607c4b7a6f2ffc8dd8e91b8e1cc64cb09fef5b927545319a6106a41eb289238b93c8f48232d5c6563e57284d8699258e7cb3fc1e9a51391c905579de5b8a8cdae0850f23551e78c90bb065ca10384078b3f462f74236196b854b79ae69b926c75eca4ea335acb6db9a04a07d2df7305c5401ff26291cc22074c2ebb98c19f938ba21039163fa26ba25b55fca5e110a4d6b05931b4231b7ba20118295bde42e656294b578f6529dc4176b328eddc3e075217f6f739027feca4b72457e15e04de0e232007d418844bebce0dcb22472767881e02c8b4cfbf33f4e9ac49704b88b198c4b6aa129dbb303b3317ddfe0f2b774ae49e4a14a1879b0512c8cf0366bca202baeb3861c6731432278a7e964b6c0c4d04a23c800cff6a2f4bc13e432f9184e0685c6dc34f02c6c17b7b82a105477c00e82df6a720e0caa3920c932432f260b078638fe346e5629c3b18d198c99249107d204c697658069adf072794d00998105a3db12244606ef108b701ad811065e704538dff640b8d0fdb5bffcb56e5d5622e6bdf2a8160e026825deda4bb22b5211b4619078268aef12da37d6cbd2f538ce7589c34e46b31c790e84afdce876612a89db355f932c2a1861d3be47bcaaac7b64d3e5124677085a2ef1b3687787280cd687e9c50dd9bb0d9aeb95dbeef788c65960dd295a85166e8892181d5ac388b183f811f1d08c6332ab4f1df70eb5388b2d13dd539efd94f6b2ec0ac2d04465a0ae59c4878949de08b82c1198fed5be13c26b0d28824b443a3d0eee91c9af7f1dae7fad5b260add2ba5abcc7c59a29cbede6a92fbe5dde5ec2b4e80498a8d371cae9c6fe4f5cd62818cbf020fa1ecdbba7d359371210a61c4586e44def6bc4734c4285b7a085d17c6f132be2eb16ffca7128e975c2a7691555b435c92d8b755121aaa62693785d3e85a899810a53ea85ae40d19a67a29eab6e4d243307ff9fa2ee17c697287054e3548d0e711f9a348d2bd4a9ebd254ff3904a60c3ed230a187c3efa1b649efab0ce2aa9de2db9a982c127b492bb4473376f61949729fc0d15c632431378a89aca3ca81d7b36049533bba51bc175b54b7d53364e7004a3bb448ffdc7ddd24c11207950e9ee7a3fa838dea33f42164a0faaec071d1279c0c183dac4b53c6aedfdcaf58206a9c132447943243f7452bc2df2983463e3e486752b251ffa38badac4890389cb39e76db95be35f21e5e11b6ea994f361b4422404071f91bdd36e676a37c7c8068db2752d23720ce41155b27571d4b23666147881137f5bb3f5e4ccf4d1b735779e44de142e5fb0251f8c692318e56f69e6975664151749fe3aa29bf888a9ce249183447ed106f3cffd7b6ac5d5fea9f2cbb2a6f2efcc6708893bdc4b98c03bba8ccda07e0dbcb818b10f2959024d74748ffa3bcbfbc42224f7522e080ec9780d23529028b1f0fa28274ceb97a4098466e2222f8147e97e121743e23ec9c164b7fb38a57af7f934293e4e83db10465e19d1aca5e32339ffc42a014caa00ca3e0dc7d5fee53e29c4081d5d47983c55042d1c1d19e5f45ee5f4faf4d1a33420e19e43c8f62cc772229c864d3970eb252ec6aba63ca7f15e8e95876e9bb2cab79f1ad213b7293d0c23bc649009206aca26a7e09a93c9428b415e906eeb02b1b98491878f92bcc04170893cc73daac938892c1c1cb5b33821a763e253e396cbd1a3eb04c6c7772b4b04b7d082c1ba29ab8c9fedd3c92d8ae5f321895477f177cdd533d2803c990bcdb22be54f88997c81e0e1c3985bbe9b57dc964480aa3b7cf9e797b26aca9a201f4c7148a8fc9a54b94cd933f7df99bc307e711e807c30f9cb88a19977b63ce1df294ea01d465e9094afa4057970b90a75d6bee67faa4e7294583ec43ead56f8b334c68c2dcf6bcca962aecd39fbc9427376bc5c9779014cbcaadc45a890a4927064156822d7c582271f14709c577ff12df69c22ae8563a6dc0d256356f9b11fc3befcf34bd9a5f36f0edc86fb2fa4a1252669df65bb3f7c632c07d7aef25e54bbaeac1ba372efa71c178b17959cc80cdd7cc7aa764d4ec4da85cbb943ea60b3f265be7b746a6fe1118d7dff2bbb1df956f434d2e10e4359d97fbefcf30bb1fdd13ba845c2c1f31d5d17fb4e44aa305d19433e7c63d264d97a3abe7e292eff9d161aa4d7912fc5dbd96b18d838628ec2d3e51e426d9be90ea9dafec835e9fcd6c485de2b579deeb822bcafb8ea2cdd3db237ca4bfa811dc424b8561e5db82278ba657253aedaa9b82678d849b924556ea8346593cd8de2d05453ee852d8e8ba2f59d8e8b42271b1e37e5ca7d8f0d3457e47ced078c93fd09de2045a848da1fdf2d92deb04db663c801f99b479ddf4c2fa80e18fff6b96a19bf61fb375419077f2bce6dca582d5fdcfafea5f5adc57f21c7bdf371737fccfff679ed5b07fcffa738475599e90f7104c8428df8c0ab388152e6efc20874d83d4680fbfb3002029f63045e28468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062042846806204284680620428468062041ec70854e7fbff529a0019047f597118e1cf6f09390577952e702a5c61069e844e451968f34fa77801f66b8bfbca711f6cfb7bbbf3bdf552870a2c4d14dfa60ab4f65481564515e0f9fc6cfe035481bc908f42059eaae3ffcf2f9d27bef3d212cea0024feccb538b1384974a94fdfb61020cf2c02950e0d0708acc736ec0810c7038e95ff4e2f2a07f595bc727fdeba7f60be993fe5efce7fd87e1808e10ffb711e2accfee478c2fef3d31035c8816fcc49973d3e87d36189bda3803fc786d0493f463a5ce4768bc3233e51f92831de5c77662f99d8da10f5838b733c0ab9b77922ebd3aefbda96bf936b2bb46b6d05ac8e63a99b91352439f7e027e8016bab879cbdadb77a9335fe883c0d0a7b2e5f71243b7d7961fff43f25ec97d5ea69c9a1afac0b56575666b026b6a2d34e13aa9e1a3c0ced3d11aac8c10c8eaee3d9866b6367f19b13602be9a2df4299a076a6acb2831e6e335f08dd0e0f3f41070826c6a5b64edda43f23ba39698015e44563015a6fc606debafd5330e6c594df66965b9e672efd3e47a81a10e90e1ab99a1099fc64c1c007f1cdbda14bdcf0622d07a4f806ba71f722f59f85377b41aaf2d7fee293d0359c138045cbba37c76d337a9bd51bcd7f5c176a2bbe012d7e0d499a119c8f2d574c1cd9d99267c82beba323e586fa2b55ce877d2d16aeb026db05acc840f431f840b6d1b42bfc79a1ac90b77806b9fffdeec3ff27be2bc8bfac4c636d713a6ba814030dd299ea819fa60477eff7da66c47e4f73cc519eddac3076cf7d3d06d1670c20e70067be13936a3cfd7f42d2bee4dee3b62116b68adbee5775ad68fe2f7debbc769e479951fdb2ee0a73f0d7dfa03c8680eb84e3cf755dfd00708f863a47451bae0b62d439e7794552f59e8e11a78c2db426b7986dc4d55b9b306fdb793f228e9dbacbddddbf5c7663dd3a7081cfac5f05dea90fef26f431bb3ef0efed7973fee9ff3b306182118dde19f4f852bffdc7a16da571cf49eff237ce785ef02ffb883e6fe130eba28e51ff0d0b9a8c00b3cf5d0d443ff251efab4231e3cb4ad8f43e05bce9c473be2957e6ec296c5a1d4c8c40fa88f594363d309d78981ac7eda325a83e02d2d3d68b8d8c5c353fdd16a1b82a0fb2c05eaced48440e98d058b9f2230137e1afaa4297d6ef60768a14d49395255eeed4cfeed5972f6e5b8e4f50ef7ea9e8fa813551c4cb91e6be8b60be72a6768026bede2e1414740363f5d83a09889cc82f11a7cc643c97b592bdddecee2d464e16f0545eeb1767f102e02953566220b321101bfe70179eed8b28b946ee5bd44dfd684c2469ebd53bae1c707db764c4dd8d8fac4b1e51767e1cf9d05d7496d5fcd6c595d29324af3f4d96b3e1352e471bcd0c7bb911302f269682d0ff4574ffbdf50c7c8f2910b64f464e80ab6fb6863681d7fd8f0fc734ecd4cad179b7a58cc683c6168719507af7b76351bce902d39614a6655164f3cf7dc59e8e2460a6232937194bed85af8db7091899f40eeedac4c94f7cf1abc9532a52cf92ebdae47d98bf3de1fb780d672ad6055cd72f27fa38cd8b8eb54f5a9c8460bf8e37c86a1f4a778a14f1ccb57595b1fa45626ba4a7f1c025d8c8d99389cb39d9f8adcf14c5ffdb4a5f6f96f4a9d9dadb592853e10aad948f1ef653dca5e5f2cb9972d34d6016466f8c17a96af6e6d4dddd992f28f77a9b359e803177c34dcb73f40765fcd40f046e432439fb62c5fd81dfdc6c14eaec5a3749189f3853e8edebbbdc96c6e931927bfd011f1d86da80e90c58ba4fda1774f7c86d96b3a0dd474c1e733c99fa42e947eab33bca4ababc8e227cea1fc1b67362fdb099999065304fb93a2bdc96adb9656b567129fa520791ecdc4d0f05ed3796e9316b26535b332b1b39cd4ed56d9409c9bf2dcb1e41e6b4a6261274d65c9cc4c913bbed24f9e154998013eef279de5ecc886e41e8eedf732a52fbab6ec4e8ef47f90fa50f97a1ba9da14e4e344e90f5ce0db48918463bd7eab73a6533e5f6e4f1f21204ff7b6547eb0357b917b4df1d1b3b7620ff2b1335a092ed0e6ce52673d7db629ca20aba92135d946ecfcdce00bcf7aa8e3ea19951b6da1c90647d7d5bfde985d9036c88ed7863c77de67afd8d40476387759bb2f7ed864dcd2c73f003745a35567347a3dabd3fc7ae2775670f6da51baf9b8f003700247565f23541b6be6557f735343b71c9889b9ed9aec3992c473bb66af9da67a1f49e2d8d0c7bb856623722f65e522206fd74a9facd8b62b453632c0b14e5127e45eee3aaf8359d15fe67ea765cb453d297d361cbd86cf30631d43dbee8c59635d710b5d216d6067c99dd4e288ddc4fc998efc854a566eae303fc89dd501dc34db73ce4f5dbbafee265c676510bb4a6e08824938eb4f59ebb8ced20f368e2fb45f09705381d824f74b2bd29fc94a65521fd7d10737c867fe43594076263e2b3d6263873c5366e8bd96a18fd911cad362a54bc6f6b16be4cffceacd643506f28b4356d1409e3846305883d2ae1f9aca9a726745c69ff37ead38cbd92a2ec70f04fc09b17f731bedbac4af232b78dbdb792aa3c247fabd0c7e6c77c4b702df8a95fe98b57c941abb36695fcf8a34206d91257e7ba44e054b9e7b23e9d5237e09c8e8b35871b7d726b18f47ca249e8f057df6593af201e49f588edded7a3b78aeeb2e27657feeb3c3e5e4af585515ff97699406c15debaa73f16a65f5dc3a443e39f6e2c2aafd9d6f7fe784c717567cd3c2aaf5f2f2d0c2eab9f567229ff9a2a9f5f424d075155d57fd25ebaaf38ef8d8ca6a367fb9b1d2399a2df46c5f4dedfed1eaa829aef57c75365fcd847935011a1985ab991a4917494c744766e1c6d18a46f401af142b9ede983574233466620cb8b10b247167e803ced09554e9e57ae2bcbb72f6de7426a2bcdc92185a9998195a6f558eb6f92aa1f43c3b5b9e86c0135de251f2dfe90f90c5ab71a9c75b3e626d594d95fe745d958fc45bc9ccc2e2c7c89044d7ca4496cc520d1f6564764ce2c0b6d6422098a4f5df6c5a25587dd5239ea55a8529dded7ac1f56212ef25b1df425f3cf2147b9d7eeed9c9cc9f031c5a29dd966b6a6d6cf1d38cac36b5169b97c5ca4e66e1b57be5697dc3b5bc83f76e9a014c6575575bbdbd2ffc3cbefe72610653cc767fb43189715b5c273666c20078c28cd4832a777ac09fe0e16c75a52ce30d899be6b39962a6515ffde1e14cb8bc7ae9ef572ab1220b2d206fc2d18ac4f07bfc42432c59c50e6742dfd0a733431fb7407f82871ff1f0ac2c5d128f9fa72a3f086d591581bc9d1bbacb0ee55e66f063f0d663e33b67b0b5e759853febb3b83efb57797d075ff7eff94b2b7f1331bdfddf404c7f69e5c4f44eb38bbfe4bfff66577feae66f7b194a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4ca7c4744a4cff33c47407ff55b474079f311daf12054e852bbc40abf5c2ff5112abf01f21b1e6a5a424564a62fdff4b626de8b107569055f1ab6762b2d0502a3921215eaead60e20c4baa9da92d1c4273b3fc5e62e805558d90d7a4d9c007fac4931c227720b249b34160ea536c6b4abae03ac988ebf156d6716dfe2d01155f48b3d723aea4a366ca033a256972a60c8793fc772f9124d7a34cdc9c1301c9739404d59c0ce838d671fedaf0ce287fa914e4243822cb9abd635b583c61b58fd9bba8910e6a26445614ba4d451214720a9e14b00519b620d536100e0bae51c51f6aa6641634c3459dcee7843963e9f27ddd030b48aeeae998933eef0fd6b08f6695dc70d668ef67c94107f2dd99fdc61511345b684260cc1aeb7220e9390d5036353536ba7b4ae69372e0fca4f3f37b0325c8f50e7646ec194150d20977e7b80d13569329f75243de22cb13c3131a6c9d4fc59a728f55fa53d7e4d49d22779d8abda4107d495c81325d953baad51791e5b58706611665225e68c20ac86a56e3153946c99f1fad2e103b0bae51a8482e2ab95ce905065345198c15d256fc4e369cdd24b00e8efbc3eb534e75d47aab73fbbee103857335acd7f3cd6728a9a3708307927a44a77c527e289b376933942628a751fedc33994432dea4735e246c2bd6f09c3d4d527d982629f2648c52a479647b5d6f74d26786b3cd50d2d9589185109cd33d071525f876df28994f7a4c9ee7888efad37bcdde7ebcc692b31ae434d48f1a13aa2292f61e2692ba8ad754f6da78e213eaf19b03b45e083c2724e396a455e3aa40ca98d9927b689b93b02339fffa935caafcadedfc08ee753e554dee6fe3543dfd1770aa9e5982a27c165a9453453955945345395594534539559453453955945345395594534539559453453955945345395594534539559453453955945345395594534539559453453955945345395594534539559453453955945345395594534539559453453955945345395594534539559453453955bfc7a9fa5ff6ceb6395164ede31fe8aead1b1a49c2bc13a2d8886482ca43bfd9a2210368a3ec1a54ac3adffdd4d520681267674ecda4ea9cea17a9ddd1a6e9bebab91e9ae4f7ffc7c6825325385582532538558253253855825325385582532538558253253855825325385582532538558253f5bfcda9baf8fbfd4fe255f577fc35dcaa01527f2bb6eafe5760abf82005b54a50abfe7ba955df796e7b7a55e4cb07aa58405e4a43a455c9c4da5320f6ac2d39f28f6b12e0f4db5c2fe2427bc586754a4c0f45fef23509dc924e66f9d3613b2541d6f4613a40a4915a528cd9117ed617b421dec6ad293aee48601989cf76c4f46a9b5959225bfb2470d9bca3060d356c8e4f2f40593280a6e40061e836e5687e68e92ee7fbc99744a2123f4aff07a4164e8799582c997835cd81e834ab62e455c4c8eeb1a9fdfd94eb52bcf1986de8af24704ea19fb0a77cd891722e09392e508814b7a4e8829004c4a4ef10ac6ca3a5eb14e3574ec3596cdf8c5baace04a4a9c12ee7907e9bafef8d8d746fa4257b993494315837a01e357d64192d5cb06d3f9e47e9d6da56a1623120ee34f41b0f77c49adb6b583e1db69f43b2697e0dec0742cd45bb2eca5cc01191f41d988d8cbe28f2cf4799878fa28cfcf0f07351e677b211ef555516514644994f8b32174fe1c701869ada2af40fff1050ca2b273f6faf6fd06a1768c55ccfe2fa8d23edfac5959d6b674ce0aef90e736c1b36c1d1f3be5680154b0cbde648b3607687cde33e44e39dbd6efebb445e9d145edd3a471acc871a1e7344611a17e38aa0656ab32668b5e8378e1f7b395c60c81a44e21d362c3e1e8e8033f878764687651ba6b445ad41a0798f28bb6edb61f556e7c0c15e6353ab931eeb57359f03a6cc91225fbbb0d1a10d80d2ee8d3d1208e6e76012b773c78fd279ddba60b1e0ff3e2e48602112e06ddcd8e7345deca6e7ebece258c68acb689eb5f3d6ea84cffb904e8d75fa7531e8ecc4ef89b41d99ab3b8ae22d660e8b37e48c0ddc458123115f2d6911a761f09c4613578a1fb780b2e4eb1499da29792cdfdc07974f875283317d62b0fa338b36097bf9fbcfd5ee47e3d6bb4bce21ec6120ff48081b7c51065f90faf3214cfb15218c0ff2778530f9ee4e15214c84b0cf0d61ef1ec83e9a01d474b9f12a0e33855226d04b6a7aebebb2065f824f0baae006963b7624129092ccf51d454e460dfdd47ad00a8fdd2d99ebfa72b44e3b086903e06d00a50607a87250ed1964192bee3af20729a4ec78c2af871249a693e7340a6669a2586532010fac4bb485db92261a1ec2c03911ff390da19d99310ec8358f1978fe30b036892f33ba79ae8ca22de94c728248d0c04a594de6694e36b31cbc6bd766fcda012f5d7e9f436f87a67cd871d8ace2ed921101bb25bccf89b50f91778a6b358b8b04d91b6717f90e9b3e8207df4ea9e949d864553cf124688f7388e47a4d91c3626556b57680124e727d7545910b91628b379e4456291fe3c53d4e89e9963457f7344f73628ea5708e77d8c856d1c4aba2fa3a3245be7a4882e7747a82122a9369b183f28b8feb669fb01ef9210d03470548e8db68974c2cb52b4321121bfa3913e9ec776deb1e24dad895d16bbb7a10b9bbec009bacc04626bd043aa328bcd88bc3eceb424a71d16705385f77e3ea7f74299a58fba4d62b12c4a91b583555f0361c9d4b4b9c5a937ead79661148292d34099b649fc0fc4d5661c3bdefc7c9d75b0b8df5fd6526f3ed79fb911dba7dfc75ae67091a9797b6998ecac5421a5400d98dd172dbae510965f5b7f975ff6433abf873607a66e41fd952615558676dff2effcc36f4e952d29eec4d0b9046ac78999fdb3c97b07fda8c290dd1580a519a4ecd65f9d11a52746476d18282cd6579b52e937ecf92202b13233d5ef43de563ff2c482cff75b0287df913b0513f90a9bc6f7ece52b4fbefa910487fc8e80f84204b19685fe4879fcd529024fd8ae35cedfef714da77d2c39d8c54f54114daa2d0feb442fbfdc3d867288d37b72a7ef8b7daa6eff0ed39afff72a8d162c4f634c777d8c0477b35ac66465b67162e8b91534781de4489be9f11099c555cb0438b6eef6bd342ce227f9043fd1af9ea9a042960d5737bc8c7730a15ab8c27ee09afb67dc4299a4366f88c7b41c3926274be6698e28d25d35c6eb32518a725c5b55c51e4326c0cf689291f6cbfadd911ab88e90dec8d7b027cf7b93fc0eaf7fd0ff3c55a1bb99e365eb0504b4cef9498ac22f5b0b291ba27a6f78dfada3af18f2cae1faab060f0b91ad6fa2b45ce369c0f5fedc02da9efed212b982d8687d9e330b5e7f28aa2e31ea40cecb9ccb1ff5160313c714fb60ffd2ef771e16d781d1be0b60d9c63b82ccef5536c7a2b3c7159ac3cefa7f9763fcd0187dedad518e661e04954e1df837d53bc56bfba23ef9bbb54fdaf80e98703e8224ea9afad227304fd4b1164700a644c2318e3011bd26b88b41d55ac13fc7f9f5de89d4d6dc56204313893a9db36fcec05227eace82cac61fe80a277b6f07dd244c3579e5506b3d4529c3aac1ffe9ae60f7fc13ad886ceed6fd7dbbfec7a0bfb61f3c13ca7d8d0b5769fac494046043297c265b4704a12ccf2a75c3f47f5fc79e2aab1e99d1a490c7917fa724ecc11d8250f5669355b0c2b67f5dcefa1b5ce33a1b8d67240e1db819ec51b87c57cdf5b2fb3f9e0f8341f1c2ef6cc3099b0030966fb106559346fda39c6a0b657a3e347ede005480c520175d7f6e8d403f5a3b6ed1af5e3353ebe37f5b59aa071f3ecb1c1d15eadab598e77efedc0f757dbdfe870f51cc3de793787616f9b42e6736cef31e0d7f6674d793299bdd2425b93f67ab0d3d5f55cd2c36b9fede7760ccfd56c3ea871ba6dce98cefe04cedd4caf0a1163dc4e5df6645d4994f4f31d56cec57ce12c292918b3034f8afc23f723b880ac727967e7dabb734498ffb7cfc4eeffffebaef9ab8c1f4da93e687fcea9ee06da7772aaeee447fda2dc7f51b59fcfa9e45f9153f141feae931f7570af89931f71f2f369273f1f3c8d6f932a7064dd914fbd509ae4e8da9975ceaa8efc26f14826964ce66707cf7f7278e1e09aac86ef716195b47774e7c4674f15771405983b553b7fe89d6e9bd8c0310d091aa74b82593d7b1c219cbfb94f9bcc34f771f6d497f7b4284fb7da2d0bef44d1516e923beef47962f0b67d8c34392e1cb6f0c7875b7384a048020b92a52a31c73bd7f45654b1b2c4d4eac666a0f9b47c336f781bef4a2ff31bdf17eade2dc63b3a71f94b0782b2c73070582cb16aa924254f4c6ed9bcd02cd01e8a7ca74cc6eddbff0d4f2e9ab7fdd7f62da862adc8921f6d352f32362edcaba44562d135041e8fbf61bf3997c23b2d5abbce416b27b0f6b1d1b53d97fde76bf2088eae265e136cc7d7fb8920ef9c44bddd0707aa3837bf4b8273a2f5767fe93c89bb71dd9af8479698cb8faf2d3216fa37fa2db4a630d8cc6e7c3fae5ee64d82c813046398063f18a41b17fef29ffbf0b7fefb9fdd87d04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14a19d22b45384768ad04e11da29423b4568a708ed14d04ef9d7bf010000ffff0300c003bf2e09770300`)))